@@ -0,0 +1,267 @@
+// Package conns holds the CloudBolt API client shared by the provider services.
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+)
+
+// pageSize is the number of objects requested per page by GetAll.
+const pageSize = 100
+
+// Guardrails are provider-side ordering limits enforced before CloudBolt's own permissions.
+// Empty lists and a zero MaxMonthlyRate disable the corresponding check.
+type Guardrails struct {
+	// AllowedGroups holds Group API paths, global ids or names.
+	AllowedGroups       []string
+	AllowedBlueprintIDs []string
+	MaxMonthlyRate      float64
+}
+
+// CloudBoltClient wraps the CloudBolt SDK client.
+// The SDK covers the objects the provider has always managed, CloudBoltClient adds
+// authenticated JSON requests for the API endpoints the SDK does not implement yet.
+type CloudBoltClient struct {
+	*cbclient.CloudBoltClient
+
+	Guardrails Guardrails
+	// ReadOnly is set by the provider read_only setting, resources refuse to create, update or delete.
+	ReadOnly bool
+
+	baseURL    url.URL
+	httpClient *http.Client
+	username   string
+	password   string
+	domain     string
+	token      string
+}
+
+// New returns an initialized CloudBoltClient, it accepts the same arguments as cbclient.New.
+//
+// New does not make any API calls, CloudBoltClient.Authenticate must be called before use.
+func New(protocol string, host string, port string, username string, password string, domain string, httpClient *http.Client) *CloudBoltClient {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	return &CloudBoltClient{
+		CloudBoltClient: cbclient.New(protocol, host, port, username, password, domain, httpClient),
+		baseURL: url.URL{
+			Scheme: protocol,
+			Host:   fmt.Sprintf("%s:%s", host, port),
+		},
+		httpClient: httpClient,
+		username:   username,
+		password:   password,
+		domain:     domain,
+	}
+}
+
+// Authenticate requests API tokens for both the SDK client and the JSON requests.
+func (c *CloudBoltClient) Authenticate() (int, error) {
+	statusCode, err := c.CloudBoltClient.Authenticate()
+	if err != nil {
+		return statusCode, err
+	}
+
+	return c.authenticate()
+}
+
+// APIEndpoint formats a CloudBolt API path, e.g. APIEndpoint("cmp", "servers") -> "/api/v3/cmp/servers/"
+func APIEndpoint(paths ...string) string {
+	return fmt.Sprintf("/%s/", path.Join(append([]string{"api", "v3"}, paths...)...))
+}
+
+// Get fetches the object at apiPath and decodes it into out.
+// Returns cbclient.ErrNotFound if CloudBolt responds with 404.
+func (c *CloudBoltClient) Get(apiPath string, query url.Values, out interface{}) error {
+	return c.do(http.MethodGet, apiPath, query, nil, out)
+}
+
+// Post sends body as JSON to apiPath and decodes the response into out, out may be nil.
+func (c *CloudBoltClient) Post(apiPath string, body interface{}, out interface{}) error {
+	return c.do(http.MethodPost, apiPath, nil, body, out)
+}
+
+// Put sends body as JSON to apiPath and decodes the response into out, out may be nil.
+func (c *CloudBoltClient) Put(apiPath string, body interface{}, out interface{}) error {
+	return c.do(http.MethodPut, apiPath, nil, body, out)
+}
+
+// Patch sends the changed fields in body as JSON to apiPath and decodes the response into out, out may be nil.
+func (c *CloudBoltClient) Patch(apiPath string, body interface{}, out interface{}) error {
+	return c.do(http.MethodPatch, apiPath, nil, body, out)
+}
+
+// Delete deletes the object at apiPath.
+func (c *CloudBoltClient) Delete(apiPath string) error {
+	return c.do(http.MethodDelete, apiPath, nil, nil, nil)
+}
+
+// GetAll fetches every page of a list endpoint and returns the objects embedded under embeddedKey,
+// e.g. GetAll("/api/v3/cmp/servers/", query, "servers").
+func (c *CloudBoltClient) GetAll(apiPath string, query url.Values, embeddedKey string) ([]json.RawMessage, error) {
+	objects := make([]json.RawMessage, 0)
+
+	pageQuery := url.Values{}
+	for k, v := range query {
+		pageQuery[k] = v
+	}
+	pageQuery.Set("page_size", strconv.Itoa(pageSize))
+
+	for page := 1; ; page++ {
+		pageQuery.Set("page", strconv.Itoa(page))
+
+		var res struct {
+			cbclient.CloudBoltResult
+			Embedded map[string][]json.RawMessage `json:"_embedded"`
+		}
+
+		if err := c.Get(apiPath, pageQuery, &res); err != nil {
+			return nil, err
+		}
+
+		pageObjects := res.Embedded[embeddedKey]
+		objects = append(objects, pageObjects...)
+
+		if len(pageObjects) == 0 || len(objects) >= res.Total {
+			return objects, nil
+		}
+	}
+}
+
+// Filter formats CloudBolt list filters, e.g. Filter("name:foo", "status:ACTIVE") -> "name:foo;status:ACTIVE"
+func Filter(conditions ...string) string {
+	nonEmpty := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition != "" {
+			nonEmpty = append(nonEmpty, condition)
+		}
+	}
+
+	return strings.Join(nonEmpty, ";")
+}
+
+func (c *CloudBoltClient) authenticate() (int, error) {
+	userCreds := map[string]string{
+		"username": c.username,
+		"password": c.password,
+	}
+
+	if c.domain != "" {
+		userCreds["domain"] = c.domain
+	}
+
+	reqJSON, err := json.Marshal(userCreds)
+	if err != nil {
+		return -1, err
+	}
+
+	apiurl := c.baseURL
+	apiurl.Path = APIEndpoint("cmp", "apiToken")
+
+	req, err := http.NewRequest(http.MethodPost, apiurl.String(), bytes.NewBuffer(reqJSON))
+	if err != nil {
+		return -1, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return -1, fmt.Errorf("Failed to create the API client. %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return resp.StatusCode, fmt.Errorf("Received bad HTTP response %d: %s", resp.StatusCode, resp.Status)
+	}
+
+	var userAuthData struct {
+		Token string `json:"token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&userAuthData); err != nil {
+		return resp.StatusCode, err
+	}
+
+	c.token = userAuthData.Token
+
+	return resp.StatusCode, nil
+}
+
+// do makes a JSON request, it re-authenticates exactly once if CloudBolt responds with 401 or 403.
+func (c *CloudBoltClient) do(method string, apiPath string, query url.Values, body interface{}, out interface{}) error {
+	var reqJSON []byte
+	if body != nil {
+		var err error
+		reqJSON, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	apiurl := c.baseURL
+	apiurl.Path = apiPath
+	if query != nil {
+		apiurl.RawQuery = query.Encode()
+	}
+
+	resp, err := c.send(method, apiurl.String(), reqJSON)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		resp.Body.Close()
+
+		if _, err := c.authenticate(); err != nil {
+			return err
+		}
+
+		resp, err = c.send(method, apiurl.String(), reqJSON)
+		if err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return cbclient.ErrNotFound
+	case resp.StatusCode >= 500:
+		buf := new(bytes.Buffer)
+		buf.ReadFrom(resp.Body)
+		return fmt.Errorf("received a server error: %s", buf.String())
+	case resp.StatusCode >= 400:
+		buf := new(bytes.Buffer)
+		buf.ReadFrom(resp.Body)
+		return fmt.Errorf("received an HTTP client error: %s", buf.String())
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *CloudBoltClient) send(method string, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	return c.httpClient.Do(req)
+}
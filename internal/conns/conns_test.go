@@ -0,0 +1,93 @@
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *CloudBoltClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, _ := url.Parse(server.URL)
+	return New("http", serverURL.Hostname(), serverURL.Port(), "user", "pass", "", server.Client())
+}
+
+func TestGetAllFollowsPages(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/cmp/apiToken/" {
+			fmt.Fprint(w, `{"token": "abc"}`)
+			return
+		}
+
+		if r.URL.Query().Get("filter") != "status:ACTIVE" {
+			t.Errorf("unexpected filter: %s", r.URL.RawQuery)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"total": 3, "count": 2, "_embedded": {"servers": [{"id": "SVR-1"}, {"id": "SVR-2"}]}}`)
+		case "2":
+			fmt.Fprint(w, `{"total": 3, "count": 1, "_embedded": {"servers": [{"id": "SVR-3"}]}}`)
+		default:
+			t.Errorf("unexpected page request: %s", r.URL.RawQuery)
+		}
+	})
+
+	servers, err := client.GetAll(APIEndpoint("cmp", "servers"), url.Values{"filter": {"status:ACTIVE"}}, "servers")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(servers) != 3 || !strings.Contains(string(servers[2]), "SVR-3") {
+		t.Errorf("unexpected servers: %s", servers)
+	}
+}
+
+func TestGetReauthenticatesOnce(t *testing.T) {
+	var authCount int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/cmp/apiToken/" {
+			authCount++
+			fmt.Fprint(w, `{"token": "fresh"}`)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "GRP-1"}`)
+	})
+
+	var group struct {
+		ID string `json:"id"`
+	}
+
+	if err := client.Get(APIEndpoint("cmp", "groups", "GRP-1"), nil, &group); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if authCount != 1 || group.ID != "GRP-1" {
+		t.Errorf("expected one authentication and GRP-1, got %d and %q", authCount, group.ID)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.Get(APIEndpoint("cmp", "groups", "GRP-missing"), nil, nil)
+	if !errors.Is(err, cbclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
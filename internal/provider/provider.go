@@ -7,7 +7,7 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/service/cmp"
 	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/service/onefuse"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,7 +15,7 @@ import (
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"cb_protocol": {
 				Type:         schema.TypeString,
@@ -68,6 +68,39 @@ func Provider() *schema.Provider {
 				Description: "CloudBolt API Domain, can also be set using environment variable CB_DOMAIN",
 				DefaultFunc: schema.EnvDefaultFunc("CB_DOMAIN", ""),
 			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Refuse to create, update or delete any resource, data sources still work, Default (false)",
+			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Ordering limits enforced when planning cloudbolt_bp_instance, in addition to CloudBolt permissions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Groups that may place orders, as relative API URL paths, global ids or names",
+						},
+						"allowed_blueprint_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Global ids of the Blueprints that may be ordered",
+						},
+						"max_monthly_rate": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Maximum estimated monthly rate of a single instance",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -75,10 +108,18 @@ func Provider() *schema.Provider {
 			"cloudbolt_blueprint_ref":             cmp.DataSourceCloudBoltBlueprint(),
 			"cloudbolt_environment_ref":           cmp.DataSourceCloudBoltEnvironment(),
 			"cloudbolt_osbuild_ref":               cmp.DataSourceCloudBoltOSBuild(),
+			"cloudbolt_parameter_ref":             cmp.DataSourceCloudBoltParameter(),
 			"cloudbolt_resource_handler_ref":      cmp.DataSourceCloudBoltResourceHandler(),
 			"cloudbolt_resource_ref":              cmp.DataSourceCloudBoltResource(),
+			"cloudbolt_resources":                 cmp.DataSourceCloudBoltResources(),
 			"cloudbolt_resource_jobs_ref":         cmp.DataSourceCloudBoltResourceJobs(),
+			"cloudbolt_role_ref":                  cmp.DataSourceCloudBoltRole(),
 			"cloudbolt_server_ref":                cmp.DataSourceCloudBoltServer(),
+			"cloudbolt_servers":                   cmp.DataSourceCloudBoltServers(),
+			"cloudbolt_order_estimate":            cmp.DataSourceCloudBoltOrderEstimate(),
+			"cloudbolt_order_ref":                 cmp.DataSourceCloudBoltOrder(),
+			"cloudbolt_orders":                    cmp.DataSourceCloudBoltOrders(),
+			"cloudbolt_user_ref":                  cmp.DataSourceCloudBoltUser(),
 			"cloudbolt_1f_ad_policy":              onefuse.DataSourceADPolicy(),
 			"cloudbolt_1f_ansible_tower_policy":   onefuse.DataSourceAnsibleTowerPolicy(),
 			"cloudbolt_1f_dns_policy":             onefuse.DataSourceDNSPolicy(),
@@ -95,6 +136,7 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"cloudbolt_bp_instance":                      cmp.ResourceBPInstance(),
+			"cloudbolt_group":                            cmp.ResourceCloudBoltGroup(),
 			"cloudbolt_1f_module_deployment":             onefuse.ResourceModuleDeployment(),
 			"cloudbolt_1f_ansible_tower_deployment":      onefuse.ResourceAnsibleTowerDeployment(),
 			"cloudbolt_1f_dns_record":                    onefuse.ResourceDNSReservation(),
@@ -109,6 +151,12 @@ func Provider() *schema.Provider {
 
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for name, r := range p.ResourcesMap {
+		guardReadOnly(name, r)
+	}
+
+	return p
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -145,7 +193,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		Timeout: time.Duration(d.Get("cb_timeout").(int)) * time.Second, // Default: 10 seconds
 	}
 
-	apiClient := cbclient.New(
+	apiClient := conns.New(
 		d.Get("cb_protocol").(string),
 		d.Get("cb_host").(string),
 		d.Get("cb_port").(string),
@@ -155,6 +203,17 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		httpClient,
 	)
 
+	apiClient.ReadOnly = d.Get("read_only").(bool)
+
+	if guardrails := d.Get("guardrails").([]interface{}); len(guardrails) > 0 && guardrails[0] != nil {
+		g := guardrails[0].(map[string]interface{})
+		apiClient.Guardrails = conns.Guardrails{
+			AllowedGroups:       expandStringSet(g["allowed_groups"].(*schema.Set)),
+			AllowedBlueprintIDs: expandStringSet(g["allowed_blueprint_ids"].(*schema.Set)),
+			MaxMonthlyRate:      g["max_monthly_rate"].(float64),
+		}
+	}
+
 	_, err := apiClient.Authenticate()
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -163,6 +222,42 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	return apiClient, diags
 }
 
+// guardReadOnly makes the create, update and delete functions of a resource fail
+// before any API call when the provider is configured with read_only.
+func guardReadOnly(name string, r *schema.Resource) {
+	guard := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if apiClient, ok := m.(*conns.CloudBoltClient); ok && apiClient.ReadOnly {
+				target := name
+				if d.Id() != "" {
+					target = fmt.Sprintf("%s (%s)", name, d.Id())
+				}
+
+				return diag.Errorf("Cannot %s %s: the provider is configured with read_only", operation, target)
+			}
+
+			return f(ctx, d, m)
+		}
+	}
+
+	r.CreateContext = guard("create", r.CreateContext)
+	r.UpdateContext = guard("update", r.UpdateContext)
+	r.DeleteContext = guard("delete", r.DeleteContext)
+}
+
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
+
 func checkNotEmptyString(val interface{}, key string) (warns []string, errs []error) {
 	v := val.(string)
 	if v == "" {
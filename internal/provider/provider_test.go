@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestReadOnlyRefusesChanges(t *testing.T) {
+	apiClient := conns.New("http", "localhost", "1", "user", "pass", "", nil)
+	apiClient.ReadOnly = true
+
+	for name, r := range Provider().ResourcesMap {
+		d := r.TestResourceData()
+		d.SetId("existing")
+
+		operations := map[string]func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics{
+			"create": r.CreateContext,
+			"update": r.UpdateContext,
+			"delete": r.DeleteContext,
+		}
+
+		for operation, f := range operations {
+			if f == nil {
+				continue
+			}
+
+			diags := f(context.Background(), d, apiClient)
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, "read_only") {
+				t.Errorf("%s %s: expected read_only error, got %v", operation, name, diags)
+			}
+		}
+	}
+}
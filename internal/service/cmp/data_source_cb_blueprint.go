@@ -2,8 +2,12 @@ package cmp
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +32,122 @@ func DataSourceCloudBoltBlueprint() *schema.Resource {
 				Computed:    true,
 				Description: "The relative API URL path for the CloudBolt Blueprint.",
 			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the CloudBolt Blueprint",
+			},
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Blueprint level order parameters",
+				Elem:        blueprintParameterResource(),
+			},
+			"deployment_items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Blueprint build items in deploy sequence",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference name to use in the \"deployment_item\" block of cloudbolt_bp_instance",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The global id of the build item",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the build item",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The build item type, e.g. \"server\" or \"plugin\"",
+						},
+						"deploy_sequence": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The deploy sequence of the build item",
+						},
+						"parameters": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The order parameters of the build item",
+							Elem:        blueprintParameterResource(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// blueprintParameterResource is the schema of an order parameter read from the Blueprint deployment schema.
+func blueprintParameterResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parameter name",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parameter label shown on the order form",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parameter type, e.g. \"string\", \"integer\", \"boolean\"",
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the parameter must be provided",
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parameter default value",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The allowed values of the parameter",
+			},
+			"constraints": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The parameter constraints, e.g. minimum, maximum, minLength, maxLength, pattern",
+			},
+		},
+	}
+}
+
+// cloudBoltBlueprintDetails holds the Blueprint fields cbclient.CloudBoltReferenceFields does not.
+type cloudBoltBlueprintDetails struct {
+	Description     string `json:"description"`
+	DeploymentItems []struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		DeploySeq int    `json:"deploySeq"`
+		TierType  string `json:"tierType"`
+	} `json:"deploymentItems"`
+}
+
+// parameterConstraintKeys are the JSON schema keywords returned as parameter "constraints".
+var parameterConstraintKeys = []string{"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf", "minLength", "maxLength", "pattern", "format", "minItems", "maxItems"}
+
 func dataSourceCloudBoltBlueprintRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 	id := d.Get("id").(string)
 
@@ -60,5 +174,129 @@ func dataSourceCloudBoltBlueprintRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("name", blueprint.Name)
 	}
 
-	return nil
+	// Older CloudBolt versions, or users without access, do not get the details and deployment schema,
+	// the Blueprint is still found and the attributes read from them are left empty.
+	var diags diag.Diagnostics
+	var details cloudBoltBlueprintDetails
+	if err := apiClient.Get(blueprint.Links.Self.Href, nil, &details); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Blueprint details not found",
+			Detail:   fmt.Sprintf("CloudBolt did not return the details of Blueprint (%s), description and deployment_items are left empty: %s", blueprint.ID, err),
+		})
+	}
+
+	var deploymentSchema map[string]interface{}
+	if err := apiClient.Get(strings.TrimRight(blueprint.Links.Self.Href, "/")+"/deploymentSchema/", nil, &deploymentSchema); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Blueprint deployment schema not found",
+			Detail:   fmt.Sprintf("CloudBolt did not return the deployment schema of Blueprint (%s), parameters and the deployment item parameters are left empty: %s", blueprint.ID, err),
+		})
+	}
+
+	bpParams, itemParams := parseDeploymentSchema(deploymentSchema)
+
+	sort.SliceStable(details.DeploymentItems, func(i, j int) bool {
+		return details.DeploymentItems[i].DeploySeq < details.DeploymentItems[j].DeploySeq
+	})
+
+	deploymentItems := make([]map[string]interface{}, 0, len(details.DeploymentItems))
+	for _, item := range details.DeploymentItems {
+		name := deploymentItemName(item.TierType, item.ID)
+		parameters, ok := itemParams[name]
+		if !ok {
+			parameters = make([]map[string]interface{}, 0)
+		}
+
+		deploymentItems = append(deploymentItems, map[string]interface{}{
+			"name":            name,
+			"id":              item.ID,
+			"label":           item.Name,
+			"type":            item.TierType,
+			"deploy_sequence": item.DeploySeq,
+			"parameters":      parameters,
+		})
+	}
+
+	d.Set("description", details.Description)
+	d.Set("parameters", bpParams)
+	d.Set("deployment_items", deploymentItems)
+
+	return diags
+}
+
+// deploymentItemName returns the reference name CloudBolt expects for a build item when ordering,
+// e.g. "plugin-bdi-buphbggq" for the plugin item "BDI-buphbggq".
+func deploymentItemName(tierType string, id string) string {
+	return strings.ToLower(fmt.Sprintf("%s-%s", tierType, id))
+}
+
+// parseDeploymentSchema reads the Blueprint level and build item parameters from a Blueprint deployment schema,
+// a JSON schema describing the deploy payload. The build item parameters are keyed by the item reference name.
+func parseDeploymentSchema(deploymentSchema map[string]interface{}) ([]map[string]interface{}, map[string][]map[string]interface{}) {
+	properties, _ := deploymentSchema["properties"].(map[string]interface{})
+
+	bpParams := parseSchemaParameters(properties["parameters"])
+
+	itemParams := make(map[string][]map[string]interface{})
+	items, _ := properties["deploymentItems"].(map[string]interface{})
+	itemProperties, _ := items["properties"].(map[string]interface{})
+	for name, v := range itemProperties {
+		item, _ := v.(map[string]interface{})
+		properties, _ := item["properties"].(map[string]interface{})
+		itemParams[name] = parseSchemaParameters(properties["parameters"])
+	}
+
+	return bpParams, itemParams
+}
+
+// parseSchemaParameters converts a JSON schema object of parameters to "parameters" blocks sorted by name.
+func parseSchemaParameters(v interface{}) []map[string]interface{} {
+	parameters := make([]map[string]interface{}, 0)
+
+	object, _ := v.(map[string]interface{})
+	properties, _ := object["properties"].(map[string]interface{})
+
+	required := make(map[string]bool)
+	if requiredList, ok := object["required"].([]interface{}); ok {
+		for _, r := range requiredList {
+			required[convertValueToString(r)] = true
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, _ := properties[name].(map[string]interface{})
+
+		options := make([]string, 0)
+		enum, _ := property["enum"].([]interface{})
+		for _, option := range enum {
+			options = append(options, convertValueToString(option))
+		}
+
+		constraints := make(map[string]interface{})
+		for _, k := range parameterConstraintKeys {
+			if c, ok := property[k]; ok {
+				constraints[k] = convertValueToString(c)
+			}
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":        name,
+			"label":       convertValueToString(property["title"]),
+			"type":        convertValueToString(property["type"]),
+			"required":    required[name],
+			"default":     convertValueToString(property["default"]),
+			"options":     options,
+			"constraints": constraints,
+		})
+	}
+
+	return parameters
 }
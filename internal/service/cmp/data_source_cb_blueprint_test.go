@@ -0,0 +1,101 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeploymentItemName(t *testing.T) {
+	if name := deploymentItemName("plugin", "BDI-buphbggq"); name != "plugin-bdi-buphbggq" {
+		t.Errorf("unexpected deployment item name %q", name)
+	}
+}
+
+func TestParseDeploymentSchema(t *testing.T) {
+	var deploymentSchema map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"parameters": {
+				"type": "object",
+				"properties": {"expiration_date": {"type": "string", "format": "date"}}
+			},
+			"deploymentItems": {
+				"type": "object",
+				"properties": {
+					"server-bdi-743tlxxu": {
+						"type": "object",
+						"properties": {
+							"parameters": {
+								"type": "object",
+								"required": ["cpu_cnt"],
+								"properties": {
+									"cpu_cnt": {"type": "integer", "title": "CPUs", "default": 2, "enum": [1, 2, 4], "minimum": 1},
+									"notes": {"type": "string", "maxLength": 255}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`), &deploymentSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bpParams, itemParams := parseDeploymentSchema(deploymentSchema)
+
+	if len(bpParams) != 1 || bpParams[0]["name"] != "expiration_date" || bpParams[0]["constraints"].(map[string]interface{})["format"] != "date" {
+		t.Errorf("unexpected blueprint parameters %v", bpParams)
+	}
+
+	params := itemParams["server-bdi-743tlxxu"]
+	if len(params) != 2 {
+		t.Fatalf("expected 2 item parameters, got %v", params)
+	}
+
+	cpu := params[0]
+	if cpu["name"] != "cpu_cnt" || cpu["label"] != "CPUs" || cpu["type"] != "integer" || cpu["required"] != true || cpu["default"] != "2" {
+		t.Errorf("unexpected parameter %v", cpu)
+	}
+
+	if options := cpu["options"].([]string); len(options) != 3 || options[2] != "4" {
+		t.Errorf("unexpected options %v", options)
+	}
+
+	if params[1]["required"] != false || params[1]["constraints"].(map[string]interface{})["maxLength"] != "255" {
+		t.Errorf("unexpected parameter %v", params[1])
+	}
+}
+
+func TestDataSourceCloudBoltBlueprintReadWithoutDeploymentSchema(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/cmp/blueprints/BP-abcd1234/":
+			fmt.Fprint(w, `{"id": "BP-abcd1234", "name": "Web", "description": "Web tier", "_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-abcd1234/"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltBlueprint().Schema, map[string]interface{}{"id": "BP-abcd1234"})
+	diags := dataSourceCloudBoltBlueprintRead(context.Background(), d, client)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	if d.Id() != "BP-abcd1234" || d.Get("name") != "Web" || d.Get("description") != "Web tier" {
+		t.Errorf("expected the Blueprint to be found, got %s %v", d.Id(), d.Get("name"))
+	}
+
+	if parameters := d.Get("parameters").([]interface{}); len(parameters) != 0 {
+		t.Errorf("expected empty parameters, got %v", parameters)
+	}
+}
@@ -2,8 +2,13 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +33,113 @@ func DataSourceCloudBoltEnvironment() *schema.Resource {
 				Computed:    true,
 				Description: "The relative API URL path for the CloudBolt Environment.",
 			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the CloudBolt Environment",
+			},
+			"technology": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The technology of the Environment resource handler, e.g. \"aws\", \"vmware\"",
+			},
+			"resource_handler": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt Resource Handler of the Environment",
+			},
+			"os_builds": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The OS Builds available in the Environment",
+				Elem:        referenceResource("OS Build"),
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Networks available in the Environment, empty with a warning when CloudBolt does not find them",
+				Elem:        referenceResource("Network"),
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Groups that can use the Environment",
+				Elem:        referenceResource("Group"),
+			},
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The order parameters of the Environment with their allowed values, e.g. CPU and memory options",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parameter name",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parameter label shown on the order form",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parameter type, e.g. \"String\", \"Integer\", \"Boolean\"",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The allowed values of the parameter, empty when any value is allowed",
+						},
+						"tech_specific": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the parameter is specific to the Environment technology",
+						},
+					},
+				},
+			},
+			"quotas": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Environment quotas by name, e.g. server, cpu, memory, disk and rate",
+			},
 		},
 	}
 }
 
+// cloudBoltEnvironmentDetails holds the Environment fields cbclient.CloudBoltReferenceFields does not.
+type cloudBoltEnvironmentDetails struct {
+	Links struct {
+		ResourceHandler cbclient.CloudBoltHALItem   `json:"resourceHandler"`
+		Networks        cbclient.CloudBoltHALItem   `json:"networks"`
+		Parameters      cbclient.CloudBoltHALItem   `json:"parameters"`
+		OsBuilds        []cbclient.CloudBoltHALItem `json:"osBuilds"`
+		Groups          []cbclient.CloudBoltHALItem `json:"groups"`
+	} `json:"_links"`
+	Description            string                  `json:"description"`
+	TechTypeSlug           string                  `json:"techTypeSlug"`
+	ServerQuota            interface{}             `json:"serverQuota"`
+	RateQuota              interface{}             `json:"rateQuota"`
+	CPUQuota               interface{}             `json:"cpuQuota"`
+	MemoryQuota            interface{}             `json:"memoryQuota"`
+	DiskQuota              interface{}             `json:"diskQuota"`
+	TechSpecificParameters []cloudBoltFieldOptions `json:"techSpecificParameters"`
+}
+
+// cloudBoltFieldOptions is an order parameter and its allowed values.
+type cloudBoltFieldOptions struct {
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`
+	Label   string        `json:"label"`
+	Options []interface{} `json:"options"`
+}
+
 func dataSourceCloudBoltEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 	id := d.Get("id").(string)
 
@@ -60,5 +166,130 @@ func dataSourceCloudBoltEnvironmentRead(ctx context.Context, d *schema.ResourceD
 		d.Set("name", environment.Name)
 	}
 
-	return nil
+	var details cloudBoltEnvironmentDetails
+	if err := apiClient.Get(environment.Links.Self.Href, nil, &details); err != nil {
+		return diag.Errorf("Error getting Environment (%s) details: %s", environment.ID, err)
+	}
+
+	var diags diag.Diagnostics
+	networks := make([]map[string]interface{}, 0)
+	if details.Links.Networks.Href != "" {
+		objects, err := apiClient.GetAll(details.Links.Networks.Href, nil, "networks")
+		if errors.Is(err, cbclient.ErrNotFound) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Environment networks not found",
+				Detail:   fmt.Sprintf("CloudBolt did not find the networks of Environment (%s), networks is left empty: %s", environment.ID, err),
+			})
+		} else if err != nil {
+			return diag.Errorf("Error getting Environment (%s) networks: %s", environment.ID, err)
+		}
+
+		for _, object := range objects {
+			var network cbclient.CloudBoltReferenceFields
+			if err := json.Unmarshal(object, &network); err != nil {
+				return diag.FromErr(err)
+			}
+
+			networks = append(networks, map[string]interface{}{
+				"id":       network.ID,
+				"name":     network.Name,
+				"url_path": network.Links.Self.Href,
+			})
+		}
+	}
+
+	// Environment parameters such as CPU and memory options are listed separately from the tech-specific ones.
+	parametersHref := details.Links.Parameters.Href
+	if parametersHref == "" {
+		parametersHref = strings.TrimRight(environment.Links.Self.Href, "/") + "/parameters/"
+	}
+
+	fieldOptions := make([]cloudBoltFieldOptions, 0)
+	objects, err := apiClient.GetAll(parametersHref, nil, "parameters")
+	if errors.Is(err, cbclient.ErrNotFound) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Environment parameters not found",
+			Detail:   fmt.Sprintf("CloudBolt did not find the parameters of Environment (%s), only the tech-specific parameters are listed: %s", environment.ID, err),
+		})
+	} else if err != nil {
+		return diag.Errorf("Error getting Environment (%s) parameters: %s", environment.ID, err)
+	}
+
+	for _, object := range objects {
+		var field cloudBoltFieldOptions
+		if err := json.Unmarshal(object, &field); err != nil {
+			return diag.FromErr(err)
+		}
+		fieldOptions = append(fieldOptions, field)
+	}
+
+	parameters := append(parseFieldOptions(fieldOptions, false), parseFieldOptions(details.TechSpecificParameters, true)...)
+
+	osBuilds := make([]map[string]interface{}, 0, len(details.Links.OsBuilds))
+	for _, osb := range details.Links.OsBuilds {
+		osBuilds = append(osBuilds, halReference(osb))
+	}
+
+	groups := make([]map[string]interface{}, 0, len(details.Links.Groups))
+	for _, g := range details.Links.Groups {
+		groups = append(groups, halReference(g))
+	}
+
+	quotas := parseEnvironmentQuotas(map[string]interface{}{
+		"server": details.ServerQuota,
+		"rate":   details.RateQuota,
+		"cpu":    details.CPUQuota,
+		"memory": details.MemoryQuota,
+		"disk":   details.DiskQuota,
+	})
+
+	d.Set("description", details.Description)
+	d.Set("technology", details.TechTypeSlug)
+	d.Set("resource_handler", details.Links.ResourceHandler.Href)
+	d.Set("os_builds", osBuilds)
+	d.Set("networks", networks)
+	d.Set("groups", groups)
+	d.Set("parameters", parameters)
+	d.Set("quotas", quotas)
+
+	return diags
+}
+
+// parseEnvironmentQuotas converts the Environment quotas to strings with convertValueToPlainString,
+// e.g. 1000000 is "1000000" rather than "1e+06". Unset quotas are left out.
+func parseEnvironmentQuotas(values map[string]interface{}) map[string]interface{} {
+	quotas := make(map[string]interface{})
+	for k, v := range values {
+		if stringValue := convertValueToPlainString(v); stringValue != "" {
+			quotas[k] = stringValue
+		}
+	}
+
+	return quotas
+}
+
+// parseFieldOptions converts order parameters and their allowed values to "parameters" blocks.
+func parseFieldOptions(fields []cloudBoltFieldOptions, techSpecific bool) []map[string]interface{} {
+	parameters := make([]map[string]interface{}, 0, len(fields))
+	for _, field := range fields {
+		options := make([]string, 0, len(field.Options))
+		for _, option := range field.Options {
+			if optionMap, ok := option.(map[string]interface{}); ok {
+				option = optionMap["value"]
+			}
+			options = append(options, convertValueToString(option))
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":          field.Name,
+			"label":         field.Label,
+			"type":          field.Type,
+			"options":       options,
+			"tech_specific": techSpecific,
+		})
+	}
+
+	return parameters
 }
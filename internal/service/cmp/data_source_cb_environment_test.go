@@ -0,0 +1,61 @@
+package cmp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseFieldOptions(t *testing.T) {
+	var fields []cloudBoltFieldOptions
+	err := json.Unmarshal([]byte(`[
+		{"name": "cpu_cnt", "label": "CPUs", "type": "INT", "options": [1, 2, 4]},
+		{"name": "mem_size", "label": "Memory", "type": "DEC", "options": [{"value": "2 GB", "label": "Small"}, {"value": "4 GB"}]},
+		{"name": "notes", "label": "Notes", "type": "STR"}
+	]`), &fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parameters := parseFieldOptions(fields, true)
+	if len(parameters) != 3 {
+		t.Fatalf("expected 3 parameters, got %v", parameters)
+	}
+
+	if options := parameters[0]["options"].([]string); strings.Join(options, ",") != "1,2,4" {
+		t.Errorf("unexpected cpu_cnt options %v", options)
+	}
+
+	if options := parameters[1]["options"].([]string); strings.Join(options, ",") != "2 GB,4 GB" {
+		t.Errorf("unexpected mem_size options %v", options)
+	}
+
+	if options := parameters[2]["options"].([]string); len(options) != 0 {
+		t.Errorf("expected no notes options, got %v", options)
+	}
+
+	if parameters[1]["label"] != "Memory" || parameters[1]["type"] != "DEC" || parameters[1]["tech_specific"] != true {
+		t.Errorf("unexpected mem_size parameter %v", parameters[1])
+	}
+}
+
+func TestParseEnvironmentQuotas(t *testing.T) {
+	var values map[string]interface{}
+	err := json.Unmarshal([]byte(`{"server": 10, "rate": 1000000, "cpu": 2.5, "memory": "64 GB", "disk": null}`), &values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quotas := parseEnvironmentQuotas(values)
+
+	want := map[string]string{"server": "10", "rate": "1000000", "cpu": "2.5", "memory": "64 GB"}
+	if len(quotas) != len(want) {
+		t.Errorf("unexpected quotas %v", quotas)
+	}
+
+	for k, v := range want {
+		if quotas[k] != v {
+			t.Errorf("quota %s = %v, want %q", k, quotas[k], v)
+		}
+	}
+}
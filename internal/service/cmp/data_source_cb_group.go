@@ -2,8 +2,16 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +36,117 @@ func DataSourceCloudBoltGroup() *schema.Resource {
 				Computed:    true,
 				Description: "The relative API URL path for the CloudBolt Group.",
 			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CloudBolt Group type, e.g. \"Organization\"",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The absolute path to the CloudBolt Group, e.g. \"/My Org/Dept 1\"",
+			},
+			"parent": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the parent CloudBolt Group, empty for top level Groups",
+			},
+			"child_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The direct child Groups",
+				Elem:        referenceResource("Group"),
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Environments available to the Group",
+				Elem:        referenceResource("Environment"),
+			},
+			"blueprints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The orderable Blueprints the Group can deploy",
+				Elem:        referenceResource("Blueprint"),
+			},
+			"quotas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Group quotas, e.g. CPU, memory, disk, VMs and rate, empty with a warning when CloudBolt does not report quotas for the Group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The quota name",
+						},
+						"limit": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The quota limit, 0 when \"unlimited\"",
+						},
+						"used": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The quota usage",
+						},
+						"available": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The remaining quota, 0 when \"unlimited\"",
+						},
+						"unlimited": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the quota has no limit",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// referenceResource is the schema of a reference to another CloudBolt object.
+func referenceResource(objectType string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The global id of the CloudBolt %s", objectType),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The name of the CloudBolt %s", objectType),
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The relative API URL path for the CloudBolt %s", objectType),
+			},
+		},
+	}
+}
+
+// cloudBoltGroupDetails holds the Group fields cbclient.CloudBoltGroup does not.
+type cloudBoltGroupDetails struct {
+	Links struct {
+		Environments []cbclient.CloudBoltHALItem `json:"environments"`
+	} `json:"_links"`
+	Type string `json:"type"`
+}
+
+// cloudBoltGroupQuota is a single quota of the Group quotas endpoint, a nil Limit means unlimited.
+type cloudBoltGroupQuota struct {
+	Limit     *float64 `json:"limit"`
+	Used      float64  `json:"used"`
+	Available *float64 `json:"available"`
+}
+
 func dataSourceCloudBoltGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 	id := d.Get("id").(string)
 
@@ -60,5 +173,213 @@ func dataSourceCloudBoltGroupRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("name", group.Name)
 	}
 
-	return nil
+	var details cloudBoltGroupDetails
+	if err := apiClient.Get(group.Links.Self.Href, nil, &details); err != nil {
+		return diag.Errorf("Error getting Group (%s) details: %s", group.ID, err)
+	}
+
+	groupPath, err := getGroupPath(apiClient, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	childGroups, err := getChildGroups(apiClient, group.Links.Self.Href)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	blueprints, err := getGroupBlueprints(apiClient, group.Links.Self.Href)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	quotas, err := getGroupQuotas(apiClient, group.Links.Self.Href)
+	if errors.Is(err, cbclient.ErrNotFound) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Group quotas not found",
+			Detail:   fmt.Sprintf("CloudBolt does not report quotas for Group (%s), quotas is left empty: %s", group.ID, err),
+		})
+		quotas = make([]map[string]interface{}, 0)
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environments := make([]map[string]interface{}, 0, len(details.Links.Environments))
+	for _, env := range details.Links.Environments {
+		environments = append(environments, halReference(env))
+	}
+
+	d.Set("type", details.Type)
+	d.Set("path", groupPath)
+	d.Set("parent", group.Parent.Href)
+	d.Set("child_groups", childGroups)
+	d.Set("environments", environments)
+	d.Set("blueprints", blueprints)
+	d.Set("quotas", quotas)
+
+	return diags
+}
+
+// halReference converts a HAL link to a reference block.
+func halReference(item cbclient.CloudBoltHALItem) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       path.Base(strings.TrimRight(item.Href, "/")),
+		"name":     item.Title,
+		"url_path": item.Href,
+	}
+}
+
+// getGroupPath walks up the parent Groups to build the absolute path of a Group.
+func getGroupPath(apiClient *conns.CloudBoltClient, group *cbclient.CloudBoltGroup) (string, error) {
+	names := []string{group.Name}
+
+	parentHref := group.Parent.Href
+	for parentHref != "" {
+		parent, err := apiClient.GetGroupById(path.Base(strings.TrimRight(parentHref, "/")))
+		if err != nil {
+			return "", fmt.Errorf("Error getting parent Group (%s): %s", parentHref, err)
+		}
+
+		names = append([]string{parent.Name}, names...)
+		parentHref = parent.Parent.Href
+	}
+
+	return "/" + strings.Join(names, "/"), nil
+}
+
+// getChildGroups returns the Groups whose parent is groupHref.
+func getChildGroups(apiClient *conns.CloudBoltClient, groupHref string) ([]map[string]interface{}, error) {
+	query := url.Values{}
+	query.Set("filter", conns.Filter("parent.id:"+hrefId(groupHref)))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "groups"), query, "groups")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Groups: %s", err)
+	}
+
+	childGroups := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		var group cbclient.CloudBoltGroup
+		if err := json.Unmarshal(object, &group); err != nil {
+			return nil, err
+		}
+
+		if group.Parent.Href != "" && equivalentHref(group.Parent.Href, groupHref) {
+			childGroups = append(childGroups, map[string]interface{}{
+				"id":       group.ID,
+				"name":     group.Name,
+				"url_path": group.Links.Self.Href,
+			})
+		}
+	}
+
+	return childGroups, nil
+}
+
+// getGroupBlueprints returns the orderable Blueprints that any Group or groupHref can deploy.
+// The Blueprints any Group can deploy and those listing groupHref are listed separately, as the API filter
+// conditions can only be combined with "and".
+func getGroupBlueprints(apiClient *conns.CloudBoltClient, groupHref string) ([]map[string]interface{}, error) {
+	objects := make([]json.RawMessage, 0)
+	for _, filter := range []string{"any_group_can_deploy:true", "groups_that_can_deploy.id:" + hrefId(groupHref)} {
+		query := url.Values{}
+		query.Set("filter", conns.Filter("is_orderable:true", filter))
+
+		listed, err := apiClient.GetAll(conns.APIEndpoint("cmp", "blueprints"), query, "blueprints")
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Blueprints: %s", err)
+		}
+
+		objects = append(objects, listed...)
+	}
+
+	seen := make(map[string]bool)
+	blueprints := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		var blueprint struct {
+			cbclient.CloudBoltReferenceFields
+			Links struct {
+				Self                cbclient.CloudBoltHALItem   `json:"self"`
+				GroupsThatCanDeploy []cbclient.CloudBoltHALItem `json:"groupsThatCanDeploy"`
+			} `json:"_links"`
+			AnyGroupCanDeploy bool `json:"anyGroupCanDeploy"`
+			IsOrderable       bool `json:"isOrderable"`
+		}
+		if err := json.Unmarshal(object, &blueprint); err != nil {
+			return nil, err
+		}
+
+		if !blueprint.IsOrderable {
+			continue
+		}
+
+		canDeploy := blueprint.AnyGroupCanDeploy
+		for _, g := range blueprint.Links.GroupsThatCanDeploy {
+			if equivalentHref(g.Href, groupHref) {
+				canDeploy = true
+				break
+			}
+		}
+
+		if canDeploy && !seen[blueprint.ID] {
+			seen[blueprint.ID] = true
+			blueprints = append(blueprints, map[string]interface{}{
+				"id":       blueprint.ID,
+				"name":     blueprint.Name,
+				"url_path": blueprint.Links.Self.Href,
+			})
+		}
+	}
+
+	return blueprints, nil
+}
+
+// getGroupQuotas returns the Group quotas sorted by name, the error wraps cbclient.ErrNotFound when CloudBolt
+// does not report quotas for the Group.
+func getGroupQuotas(apiClient *conns.CloudBoltClient, groupHref string) ([]map[string]interface{}, error) {
+	var res map[string]json.RawMessage
+	if err := apiClient.Get(strings.TrimRight(groupHref, "/")+"/quotas/", nil, &res); err != nil {
+		return nil, fmt.Errorf("Error getting Group (%s) quotas: %w", groupHref, err)
+	}
+
+	return parseGroupQuotas(res), nil
+}
+
+func parseGroupQuotas(res map[string]json.RawMessage) []map[string]interface{} {
+	names := make([]string, 0, len(res))
+	for name := range res {
+		if !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	quotas := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		var quota cloudBoltGroupQuota
+		if err := json.Unmarshal(res[name], &quota); err != nil {
+			continue
+		}
+
+		q := map[string]interface{}{
+			"name":      name,
+			"used":      quota.Used,
+			"unlimited": quota.Limit == nil,
+		}
+
+		if quota.Limit != nil {
+			q["limit"] = *quota.Limit
+			if quota.Available != nil {
+				q["available"] = *quota.Available
+			} else {
+				q["available"] = *quota.Limit - quota.Used
+			}
+		}
+
+		quotas = append(quotas, q)
+	}
+
+	return quotas
 }
@@ -0,0 +1,110 @@
+package cmp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+)
+
+func TestParseGroupQuotas(t *testing.T) {
+	var res map[string]json.RawMessage
+	err := json.Unmarshal([]byte(`{
+		"_links": {"self": {"href": "/api/v3/cmp/groups/GRP-yfbbsfht/quotas/"}},
+		"vm_cnt": {"limit": 10, "used": 4},
+		"cpu_cnt": {"limit": null, "used": 12},
+		"rate": {"limit": 500, "used": 120.5, "available": 379.5}
+	}`), &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quotas := parseGroupQuotas(res)
+	if len(quotas) != 3 {
+		t.Fatalf("expected 3 quotas, got %v", quotas)
+	}
+
+	if quotas[0]["name"] != "cpu_cnt" || quotas[0]["unlimited"] != true {
+		t.Errorf("unexpected quota %v", quotas[0])
+	}
+
+	if quotas[1]["name"] != "rate" || quotas[1]["available"] != 379.5 {
+		t.Errorf("unexpected quota %v", quotas[1])
+	}
+
+	if quotas[2]["name"] != "vm_cnt" || quotas[2]["available"] != float64(6) {
+		t.Errorf("unexpected quota %v", quotas[2])
+	}
+}
+
+func TestGetChildGroups(t *testing.T) {
+	var request testRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request = recordRequest(t, r)
+		fmt.Fprint(w, `{"_embedded": {"groups": [
+			{"id": "GRP-child", "name": "Child", "parent": {"href": "/api/v3/cmp/groups/GRP-parent/"}, "_links": {"self": {"href": "/api/v3/cmp/groups/GRP-child/"}}}
+		]}}`)
+	})
+
+	childGroups, err := getChildGroups(client, "/api/v3/cmp/groups/GRP-parent/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if request.Query.Get("filter") != "parent.id:GRP-parent" {
+		t.Errorf("unexpected child Groups filter %q", request.Query.Get("filter"))
+	}
+
+	if len(childGroups) != 1 || childGroups[0]["id"] != "GRP-child" {
+		t.Errorf("unexpected child Groups %v", childGroups)
+	}
+}
+
+func TestGetGroupBlueprints(t *testing.T) {
+	filters := make([]string, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		filter := recordRequest(t, r).Query.Get("filter")
+		filters = append(filters, filter)
+
+		// The same Blueprint can both be deployable by any Group and list the Group.
+		if strings.Contains(filter, "any_group_can_deploy") {
+			fmt.Fprint(w, `{"_embedded": {"blueprints": [
+				{"id": "BP-any", "name": "Any", "anyGroupCanDeploy": true, "isOrderable": true, "_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-any/"}}}
+			]}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"_embedded": {"blueprints": [
+			{"id": "BP-any", "name": "Any", "anyGroupCanDeploy": true, "isOrderable": true, "_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-any/"}, "groupsThatCanDeploy": [{"href": "/api/v3/cmp/groups/GRP-abcd1234/"}]}},
+			{"id": "BP-group", "name": "Group", "isOrderable": true, "_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-group/"}, "groupsThatCanDeploy": [{"href": "/api/v3/cmp/groups/GRP-abcd1234/"}]}}
+		]}}`)
+	})
+
+	blueprints, err := getGroupBlueprints(client, "/api/v3/cmp/groups/GRP-abcd1234/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFilters := []string{"is_orderable:true;any_group_can_deploy:true", "is_orderable:true;groups_that_can_deploy.id:GRP-abcd1234"}
+	if strings.Join(filters, ",") != strings.Join(wantFilters, ",") {
+		t.Errorf("unexpected Blueprint filters %v", filters)
+	}
+
+	if len(blueprints) != 2 || blueprints[0]["id"] != "BP-any" || blueprints[1]["id"] != "BP-group" {
+		t.Errorf("unexpected Blueprints %v", blueprints)
+	}
+}
+
+func TestGetGroupQuotasNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := getGroupQuotas(client, "/api/v3/cmp/groups/GRP-abcd1234/"); !errors.Is(err, cbclient.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
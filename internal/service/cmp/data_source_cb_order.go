@@ -0,0 +1,186 @@
+package cmp
+
+import (
+	"context"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltOrder() *schema.Resource {
+	order := orderSchema()
+	order["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The global id of a CloudBolt Order, e.g. \"ORD-abcd1234\"",
+	}
+	order["jobs"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Jobs run by the Order",
+		Elem:        referenceResource("Job"),
+	}
+	order["output_messages"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The output messages of the Order",
+	}
+	order["error_messages"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The error messages of the Order",
+	}
+	order["progress_messages"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The progress messages of the Order",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltOrderRead,
+
+		Schema: order,
+	}
+}
+
+// orderSchema is the schema of an Order as returned by parseOrder.
+func orderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The global id of the CloudBolt Order",
+		},
+		"url_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The relative API URL path for the CloudBolt Order",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the CloudBolt Order",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Order status, e.g. \"ACTIVE\", \"SUCCESS\", \"FAILURE\"",
+		},
+		"rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The rate of the Order, e.g. \"4.18/month\"",
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The relative API URL path for the CloudBolt Group of the Order",
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The relative API URL path for the CloudBolt User that placed the Order",
+		},
+		"approved_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The relative API URL path for the CloudBolt User that approved the Order",
+		},
+		"blueprint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The relative API URL path for the CloudBolt Blueprint ordered",
+		},
+		"resource_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the Resource ordered",
+		},
+		"create_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the Order was created",
+		},
+		"approve_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the Order was approved",
+		},
+		"complete_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the Order completed, empty if CloudBolt does not report one",
+		},
+	}
+}
+
+// cloudBoltOrderObject holds the Order fields cbclient.CloudBoltOrder does not.
+type cloudBoltOrderObject struct {
+	cbclient.CloudBoltOrder
+	CompleteDate string `json:"completeDate"`
+}
+
+func dataSourceCloudBoltOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	id := d.Get("id").(string)
+
+	var orderObject cloudBoltOrderObject
+	if err := apiClient.Get(conns.APIEndpoint("cmp", "orders", id), nil, &orderObject); err != nil {
+		return diag.Errorf("Error getting Order (%s): %s", id, err)
+	}
+
+	order := parseOrder(&orderObject)
+
+	jobs := make([]map[string]interface{}, 0, len(orderObject.Links.Jobs))
+	for _, job := range orderObject.Links.Jobs {
+		jobs = append(jobs, halReference(job))
+	}
+
+	var status cbclient.CloudBoltOrderStatus
+	if err := apiClient.Get(conns.APIEndpoint("cmp", "orders", id, "status"), nil, &status); err != nil {
+		return diag.Errorf("Error getting Order (%s) status: %s", id, err)
+	}
+
+	d.SetId(order["id"].(string))
+	for k, v := range order {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	d.Set("jobs", jobs)
+	d.Set("output_messages", status.OutputMessages)
+	d.Set("error_messages", status.ErrorMessages)
+	d.Set("progress_messages", status.ProgressMessages)
+
+	return nil
+}
+
+// parseOrder converts an Order returned by the API to the orderSchema shape.
+func parseOrder(order *cloudBoltOrderObject) map[string]interface{} {
+	var blueprint, resourceName string
+	if len(order.DeploymentItems) > 0 {
+		blueprint = order.DeploymentItems[0].Blueprint.Href
+		resourceName = order.DeploymentItems[0].ResourceName
+	}
+
+	return map[string]interface{}{
+		"id":            order.ID,
+		"url_path":      order.Links.Self.Href,
+		"name":          order.Name,
+		"status":        order.Status,
+		"rate":          order.Rate,
+		"group":         order.Links.Group.Href,
+		"owner":         order.Links.Owner.Href,
+		"approved_by":   order.Links.ApprovedBy.Href,
+		"blueprint":     blueprint,
+		"resource_name": resourceName,
+		"create_date":   order.CreateDate,
+		"approve_date":  order.ApproveDate,
+		"complete_date": order.CompleteDate,
+	}
+}
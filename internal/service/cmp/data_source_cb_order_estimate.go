@@ -0,0 +1,257 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltOrderEstimate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltOrderEstimateRead,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The relative API URL path for the CloudBolt Group that would place the order",
+			},
+			"blueprint_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The global id of the CloudBolt Blueprint",
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Parameter Name/Value pair",
+			},
+			"deployment_item": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of blueprint deployment items",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The reference name for the blueprint deployment item",
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The relative API URL path for the CloudBolt Environment",
+						},
+						"osbuild": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The relative API URL path for the CloudBolt OS Build",
+						},
+						"parameters": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Parameter Name/Value pair",
+						},
+					},
+				},
+			},
+			"rate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The estimated rate of the order as reported by CloudBolt, e.g. \"4.18/month\"",
+			},
+			"rate_value": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The numeric part of \"rate\"",
+			},
+			"rate_time_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time unit of \"rate\", e.g. \"month\"",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The estimated rate of each deployment item",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference name for the blueprint deployment item",
+						},
+						"rate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The estimated rate of the deployment item",
+						},
+						"rate_value": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The numeric part of \"rate\"",
+						},
+						"rate_breakdown": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The estimated rate by cost category, e.g. CPU, Memory, Disk",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cloudBoltOrderEstimate is the response of the blueprint estimate endpoint.
+type cloudBoltOrderEstimate struct {
+	Rate            interface{} `json:"rate"`
+	DeploymentItems map[string]struct {
+		Rate          interface{}            `json:"rate"`
+		RateBreakdown map[string]interface{} `json:"rateBreakdown"`
+	} `json:"deploymentItems"`
+}
+
+func dataSourceCloudBoltOrderEstimateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	group := d.Get("group").(string)
+	blueprintId := d.Get("blueprint_id").(string)
+
+	bpParams := normalizeParameters(d.Get("parameters").(map[string]interface{}))
+	bpItems := expandDeploymentItems(d.Get("deployment_item").(*schema.Set).List())
+	reqData := deployBlueprintRequest(group, bpParams, bpItems)
+
+	estimate, err := estimateOrder(apiClient, blueprintId, reqData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rate, rateValue, rateTimeUnit := parseRate(estimate.Rate)
+
+	names := make([]string, 0, len(estimate.DeploymentItems))
+	for name := range estimate.DeploymentItems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		item := estimate.DeploymentItems[name]
+		itemRate, itemRateValue, _ := parseRate(item.Rate)
+
+		// Every cost category is kept, including those with a zero or empty rate.
+		rateBreakdown := make(map[string]interface{}, len(item.RateBreakdown))
+		for k, v := range item.RateBreakdown {
+			rateBreakdown[k] = convertValueToPlainString(v)
+		}
+
+		items = append(items, map[string]interface{}{
+			"name":           name,
+			"rate":           itemRate,
+			"rate_value":     itemRateValue,
+			"rate_breakdown": rateBreakdown,
+		})
+	}
+
+	reqJSON, err := json.Marshal(map[string]interface{}{"blueprint": blueprintId, "order": reqData})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(listDataSourceID([]string{string(reqJSON)}))
+
+	d.Set("rate", rate)
+	d.Set("rate_value", rateValue)
+	d.Set("rate_time_unit", rateTimeUnit)
+	d.Set("items", items)
+
+	return nil
+}
+
+// estimateOrder asks CloudBolt for the rate of an order without submitting it.
+func estimateOrder(apiClient *conns.CloudBoltClient, blueprintId string, reqData map[string]interface{}) (*cloudBoltOrderEstimate, error) {
+	var estimate cloudBoltOrderEstimate
+	if err := apiClient.Post(conns.APIEndpoint("cmp", "blueprints", blueprintId, "estimate"), reqData, &estimate); err != nil {
+		return nil, fmt.Errorf("Error estimating order for Blueprint (%s): %s", blueprintId, err)
+	}
+
+	return &estimate, nil
+}
+
+// monthlyRate converts a rate value to a monthly rate, it returns false for unknown time units.
+func monthlyRate(rateValue float64, timeUnit string) (float64, bool) {
+	switch strings.ToLower(strings.TrimSpace(timeUnit)) {
+	case "hour", "hr":
+		return rateValue * 730, true
+	case "day":
+		return rateValue * 730 / 24, true
+	case "week":
+		return rateValue * 730 / 168, true
+	case "month", "":
+		return rateValue, true
+	case "year":
+		return rateValue / 12, true
+	}
+
+	return 0, false
+}
+
+// deployBlueprintRequest builds the same order payload as the SDK DeployBlueprint, from the same arguments.
+// DeployBlueprint does not send its resource name, so neither does the estimate.
+func deployBlueprintRequest(grpPath string, bpParams map[string]interface{}, bpItems []map[string]interface{}) map[string]interface{} {
+	deployItems := make(map[string]interface{})
+	for _, v := range bpItems {
+		deployItem := map[string]interface{}{
+			"parameters": v["bp-item-paramas"].(map[string]interface{}),
+		}
+
+		if env, ok := v["environment"]; ok {
+			deployItem["environment"] = env
+		}
+
+		if osb, ok := v["osbuild"]; ok {
+			deployItem["osBuild"] = osb
+		}
+
+		deployItems[v["bp-item-name"].(string)] = deployItem
+	}
+
+	reqData := map[string]interface{}{
+		"group":           grpPath,
+		"deploymentItems": deployItems,
+	}
+
+	if bpParams != nil {
+		reqData["parameters"] = bpParams
+	}
+
+	return reqData
+}
+
+// parseRate splits a CloudBolt rate, e.g. "4.18/month", into the rate string, its value and its time unit.
+func parseRate(value interface{}) (string, float64, string) {
+	rate := strings.TrimSpace(convertValueToString(value))
+	if rate == "" {
+		return "", 0, ""
+	}
+
+	amount, timeUnit := rate, ""
+	if index := strings.Index(rate, "/"); index >= 0 {
+		amount, timeUnit = rate[:index], strings.TrimSpace(rate[index+1:])
+	}
+
+	amount = strings.TrimLeft(strings.TrimSpace(amount), "$")
+	rateValue, err := strconv.ParseFloat(strings.ReplaceAll(amount, ",", ""), 64)
+	if err != nil {
+		rateValue = 0
+	}
+
+	return rate, rateValue, timeUnit
+}
@@ -0,0 +1,92 @@
+package cmp
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeployBlueprintRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltOrderEstimate().Schema, map[string]interface{}{
+		"group":        "/api/v3/cmp/groups/GRP-abcd1234/",
+		"blueprint_id": "BP-abcd1234",
+		"parameters":   map[string]interface{}{"owner_email": "team@example.com"},
+		"deployment_item": []interface{}{
+			map[string]interface{}{
+				"name":        "server-bdi-abcd1234",
+				"environment": "/api/v3/cmp/environments/ENV-abcd1234/",
+				"osbuild":     "/api/v3/cmp/osBuilds/OSB-abcd1234/",
+				"parameters":  map[string]interface{}{"cpu_cnt": "2"},
+			},
+			map[string]interface{}{
+				"name": "plugin-bdi-abcd1234",
+			},
+		},
+	})
+
+	bpItems := expandDeploymentItems(d.Get("deployment_item").(*schema.Set).List())
+	if len(bpItems) != 2 {
+		t.Fatalf("expected 2 deployment items, got %v", bpItems)
+	}
+
+	reqData := deployBlueprintRequest(d.Get("group").(string), normalizeParameters(d.Get("parameters").(map[string]interface{})), bpItems)
+
+	if reqData["group"] != "/api/v3/cmp/groups/GRP-abcd1234/" {
+		t.Errorf("unexpected group %v", reqData["group"])
+	}
+
+	if params, _ := reqData["parameters"].(map[string]interface{}); params["owner_email"] != "team@example.com" {
+		t.Errorf("unexpected order parameters %v", reqData["parameters"])
+	}
+
+	deployItems := reqData["deploymentItems"].(map[string]interface{})
+
+	server := deployItems["server-bdi-abcd1234"].(map[string]interface{})
+	if server["environment"] != "/api/v3/cmp/environments/ENV-abcd1234/" || server["osBuild"] != "/api/v3/cmp/osBuilds/OSB-abcd1234/" {
+		t.Errorf("unexpected server deployment item %v", server)
+	}
+	if params, _ := server["parameters"].(map[string]interface{}); params["cpu_cnt"] != "2" {
+		t.Errorf("unexpected server deployment item parameters %v", server["parameters"])
+	}
+
+	plugin := deployItems["plugin-bdi-abcd1234"].(map[string]interface{})
+	if _, ok := plugin["environment"]; ok {
+		t.Errorf("expected no environment for a deployment item without one, got %v", plugin)
+	}
+	if _, ok := plugin["osBuild"]; ok {
+		t.Errorf("expected no OS build for a deployment item without one, got %v", plugin)
+	}
+
+	// Like DeployBlueprint, empty parameters are still sent.
+	reqData = deployBlueprintRequest("/api/v3/cmp/groups/GRP-abcd1234/", map[string]interface{}{}, nil)
+	if params, ok := reqData["parameters"].(map[string]interface{}); !ok || len(params) != 0 {
+		t.Errorf("expected empty order parameters to be sent, got %v", reqData)
+	}
+}
+
+func TestEstimateOrder(t *testing.T) {
+	var request testRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request = recordRequest(t, r)
+		fmt.Fprint(w, `{"rate": "4.18/month", "deploymentItems": {"server-bdi-abcd1234": {"rate": "4.18/month", "rateBreakdown": {"CPU": 2.5}}}}`)
+	})
+
+	estimate, err := estimateOrder(client, "BP-abcd1234", map[string]interface{}{"group": "/api/v3/cmp/groups/GRP-abcd1234/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if request.Method != http.MethodPost || request.Path != "/api/v3/cmp/blueprints/BP-abcd1234/estimate/" || request.Body["group"] != "/api/v3/cmp/groups/GRP-abcd1234/" {
+		t.Errorf("unexpected estimate request %+v", request)
+	}
+
+	if rate, rateValue, timeUnit := parseRate(estimate.Rate); rate != "4.18/month" || rateValue != 4.18 || timeUnit != "month" {
+		t.Errorf("unexpected rate %q %v %q", rate, rateValue, timeUnit)
+	}
+
+	if item, ok := estimate.DeploymentItems["server-bdi-abcd1234"]; !ok || item.RateBreakdown["CPU"] != 2.5 {
+		t.Errorf("unexpected deployment item estimates %v", estimate.DeploymentItems)
+	}
+}
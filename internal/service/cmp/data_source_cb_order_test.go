@@ -0,0 +1,83 @@
+package cmp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseOrder(t *testing.T) {
+	var orderObject cloudBoltOrderObject
+	err := json.Unmarshal([]byte(`{
+		"_links": {
+			"self": {"href": "/api/v3/cmp/orders/ORD-abcd1234/"},
+			"group": {"href": "/api/v3/cmp/groups/GRP-abcd1234/"},
+			"owner": {"href": "/api/v3/cloudbolt/users/USR-abcd1234/"},
+			"approvedBy": {"href": "/api/v3/cloudbolt/users/USR-efgh5678/"},
+			"jobs": [{"href": "/api/v3/cmp/jobs/JOB-abcd1234/", "title": "Deploy Blueprint"}]
+		},
+		"id": "ORD-abcd1234",
+		"name": "Order 1",
+		"status": "SUCCESS",
+		"rate": "4.18/month",
+		"createDate": "2026-01-02 10:00:00",
+		"approveDate": "2026-01-02 10:05:00",
+		"completeDate": "2026-01-02 10:30:00",
+		"deploymentItems": [{"resourceName": "web", "blueprint": {"href": "/api/v3/cmp/blueprints/BP-abcd1234/"}}]
+	}`), &orderObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := parseOrder(&orderObject)
+
+	want := map[string]interface{}{
+		"id":            "ORD-abcd1234",
+		"url_path":      "/api/v3/cmp/orders/ORD-abcd1234/",
+		"status":        "SUCCESS",
+		"group":         "/api/v3/cmp/groups/GRP-abcd1234/",
+		"approved_by":   "/api/v3/cloudbolt/users/USR-efgh5678/",
+		"blueprint":     "/api/v3/cmp/blueprints/BP-abcd1234/",
+		"resource_name": "web",
+		"complete_date": "2026-01-02 10:30:00",
+	}
+	for k, v := range want {
+		if order[k] != v {
+			t.Errorf("%s = %v, want %v", k, order[k], v)
+		}
+	}
+
+	if len(orderObject.Links.Jobs) != 1 || orderObject.Links.Jobs[0].Href != "/api/v3/cmp/jobs/JOB-abcd1234/" {
+		t.Errorf("unexpected Order jobs %v", orderObject.Links.Jobs)
+	}
+}
+
+func TestOrdersFilter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltOrders().Schema, map[string]interface{}{
+		"group":          "/api/v3/cmp/groups/GRP-abcd1234/",
+		"status":         "SUCCESS",
+		"created_after":  "2026-01-01",
+		"created_before": "2026-02-01T00:00:00",
+	})
+
+	want := "group.id:GRP-abcd1234;status.iexact:SUCCESS;create_date.gte:2026-01-01;create_date.lt:2026-02-01"
+	if got := ordersFilter(d); got != want {
+		t.Errorf("ordersFilter() = %q, want %q", got, want)
+	}
+
+	d = schema.TestResourceDataRaw(t, DataSourceCloudBoltOrders().Schema, map[string]interface{}{
+		"created_after":  "2026-01-01T09:30:00",
+		"created_before": "2026-01-31 12:00:00",
+	})
+
+	want = "create_date.gte:2026-01-01;create_date.lt:2026-02-01"
+	if got := ordersFilter(d); got != want {
+		t.Errorf("expected times to be widened to whole days, ordersFilter() = %q, want %q", got, want)
+	}
+
+	empty := schema.TestResourceDataRaw(t, DataSourceCloudBoltOrders().Schema, map[string]interface{}{})
+	if got := ordersFilter(empty); got != "" {
+		t.Errorf("expected no filter without arguments, got %q", got)
+	}
+}
@@ -0,0 +1,162 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltOrders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltOrdersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Orders of this CloudBolt Group, as a relative API URL path or global id",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Orders with this status, e.g. \"SUCCESS\"",
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Only Orders created at or after this date, e.g. \"2026-01-01\" or \"2026-01-01T00:00:00\"",
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Only Orders created before this date, e.g. \"2026-02-01\" or \"2026-02-01T00:00:00\"",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The global ids of the matching CloudBolt Orders",
+			},
+			"orders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching CloudBolt Orders, newest first",
+				Elem: &schema.Resource{
+					Schema: orderSchema(),
+				},
+			},
+		},
+	}
+}
+
+// dateFilterLayout is the layout of dates in API filters.
+const dateFilterLayout = "2006-01-02"
+
+func dataSourceCloudBoltOrdersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	group := d.Get("group").(string)
+	status := d.Get("status").(string)
+
+	var createdAfter, createdBefore time.Time
+	if v := d.Get("created_after").(string); v != "" {
+		createdAfter, _ = parseDate(v)
+	}
+	if v := d.Get("created_before").(string); v != "" {
+		createdBefore, _ = parseDate(v)
+	}
+
+	query := url.Values{}
+	if filter := ordersFilter(d); filter != "" {
+		query.Set("filter", filter)
+	}
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "orders"), query, "orders")
+	if err != nil {
+		return diag.Errorf("Error listing Orders: %s", err)
+	}
+
+	orders := make([]map[string]interface{}, 0)
+	createDates := make(map[string]time.Time)
+	for _, object := range objects {
+		var orderObject cloudBoltOrderObject
+		if err := json.Unmarshal(object, &orderObject); err != nil {
+			return diag.FromErr(err)
+		}
+
+		order := parseOrder(&orderObject)
+
+		if group != "" && !equivalentHref(order["group"].(string), group) {
+			continue
+		}
+
+		if status != "" && !strings.EqualFold(order["status"].(string), status) {
+			continue
+		}
+
+		createDate, ok := dateInRange(order["create_date"].(string), createdAfter, createdBefore)
+		if !ok {
+			continue
+		}
+
+		createDates[order["id"].(string)] = createDate
+		orders = append(orders, order)
+	}
+
+	sort.SliceStable(orders, func(i, j int) bool {
+		return createDates[orders[i]["id"].(string)].After(createDates[orders[j]["id"].(string)])
+	})
+
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order["id"].(string))
+	}
+
+	d.SetId(listDataSourceID(ids))
+	d.Set("ids", ids)
+	d.Set("orders", orders)
+
+	return nil
+}
+
+// ordersFilter builds the API filter for the data source arguments, so only the matching Orders are listed.
+// Dates are sent as whole days, a filter value cannot hold the ":" of a time, the listed Orders are then
+// checked with dateInRange for the exact bounds.
+func ordersFilter(d *schema.ResourceData) string {
+	conds := make([]string, 0)
+
+	if group := d.Get("group").(string); group != "" {
+		conds = append(conds, "group.id:"+hrefId(group))
+	}
+
+	if status := d.Get("status").(string); status != "" {
+		conds = append(conds, "status.iexact:"+status)
+	}
+
+	if v := d.Get("created_after").(string); v != "" {
+		if after, err := parseDate(v); err == nil {
+			conds = append(conds, "create_date.gte:"+after.Format(dateFilterLayout))
+		}
+	}
+
+	if v := d.Get("created_before").(string); v != "" {
+		if before, err := parseDate(v); err == nil {
+			// A bound within a day excludes the following day only.
+			day := time.Date(before.Year(), before.Month(), before.Day(), 0, 0, 0, 0, before.Location())
+			if !day.Equal(before) {
+				day = day.AddDate(0, 0, 1)
+			}
+			conds = append(conds, "create_date.lt:"+day.Format(dateFilterLayout))
+		}
+	}
+
+	return conns.Filter(conds...)
+}
@@ -0,0 +1,271 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltParameter() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltParameterRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The global id of a CloudBolt Parameter, required if \"name\" or \"url_path\" is not provided",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of a CloudBolt Parameter, required if \"id\" or \"url_path\" is not provided",
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The relative API URL path for the CloudBolt Parameter, required if \"id\" or \"name\" is not provided",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CloudBolt Group to list the \"options\" for, as a relative API URL path or global id",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CloudBolt Environment to list the \"options\" for, as a relative API URL path or global id",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of the Parameter shown in CloudBolt forms",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the Parameter",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the Parameter value, e.g. \"STR\", \"INT\", \"BOOL\"",
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a value is required for the Parameter",
+			},
+			"regex": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The regular expression values must match, empty if there is none",
+			},
+			"minimum": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The minimum value of numeric Parameters, unset if there is none",
+			},
+			"maximum": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The maximum value of numeric Parameters, unset if there is none",
+			},
+			"min_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum length of string Parameters, unset if there is none",
+			},
+			"max_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum length of string Parameters, unset if there is none",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The allowed values of the Parameter, in the \"group\" and \"environment\" when set, empty if any value is allowed. The options of the Parameter itself are used with a warning when CloudBolt cannot list them for the \"group\" and \"environment\"",
+			},
+		},
+	}
+}
+
+// cloudBoltParameter is a Parameter, also called Custom Field, as returned by the API.
+type cloudBoltParameter struct {
+	Links struct {
+		Self    cbclient.CloudBoltHALItem `json:"self"`
+		Options cbclient.CloudBoltHALItem `json:"options"`
+	} `json:"_links"`
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	Label       string                        `json:"label"`
+	Description string                        `json:"description"`
+	Type        string                        `json:"type"`
+	Required    bool                          `json:"required"`
+	Constraints cloudBoltParameterConstraints `json:"constraints"`
+	Options     []interface{}                 `json:"options"`
+}
+
+// cloudBoltParameterConstraints are the constraints on the values of a Parameter, a nil limit means there is none.
+type cloudBoltParameterConstraints struct {
+	Regex     string   `json:"regex"`
+	Minimum   *float64 `json:"minimum"`
+	Maximum   *float64 `json:"maximum"`
+	MinLength *int     `json:"minLength"`
+	MaxLength *int     `json:"maxLength"`
+}
+
+func dataSourceCloudBoltParameterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	urlPath := d.Get("url_path").(string)
+
+	if id == "" && name == "" && urlPath == "" {
+		return diag.Errorf("Either id, name, or url_path is required")
+	}
+
+	if urlPath == "" && id != "" {
+		urlPath = conns.APIEndpoint("cmp", "parameters", id)
+	}
+
+	var parameter *cloudBoltParameter
+	var err error
+	if urlPath != "" {
+		parameter = &cloudBoltParameter{}
+		if err = apiClient.Get(urlPath, nil, parameter); err != nil {
+			return diag.Errorf("Error getting Parameter (%s): %s", urlPath, err)
+		}
+	} else {
+		parameter, err = getParameterByName(apiClient, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if parameter.Links.Self.Href == "" {
+		parameter.Links.Self.Href = conns.APIEndpoint("cmp", "parameters", parameter.ID)
+	}
+
+	// Older CloudBolt versions have no options endpoint, the options of the Parameter itself would ignore the
+	// group and environment, so the lookup fails instead.
+	options, err := getParameterOptions(apiClient, parameter, d.Get("group").(string), d.Get("environment").(string))
+	if errors.Is(err, cbclient.ErrNotFound) {
+		return diag.Errorf("CloudBolt cannot list the options of Parameter (%s) for the group and environment, unset them to get the options of the Parameter itself: %s", parameter.ID, err)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(parameter.ID)
+	d.Set("name", parameter.Name)
+	d.Set("url_path", parameter.Links.Self.Href)
+	d.Set("label", parameter.Label)
+	d.Set("description", parameter.Description)
+	d.Set("type", parameter.Type)
+	d.Set("required", parameter.Required)
+	d.Set("regex", parameter.Constraints.Regex)
+	d.Set("options", options)
+
+	if parameter.Constraints.Minimum != nil {
+		d.Set("minimum", *parameter.Constraints.Minimum)
+	}
+
+	if parameter.Constraints.Maximum != nil {
+		d.Set("maximum", *parameter.Constraints.Maximum)
+	}
+
+	if parameter.Constraints.MinLength != nil {
+		d.Set("min_length", *parameter.Constraints.MinLength)
+	}
+
+	if parameter.Constraints.MaxLength != nil {
+		d.Set("max_length", *parameter.Constraints.MaxLength)
+	}
+
+	return nil
+}
+
+// getParameterByName finds the single Parameter with a name.
+func getParameterByName(apiClient *conns.CloudBoltClient, name string) (*cloudBoltParameter, error) {
+	query := url.Values{}
+	query.Set("filter", conns.Filter("name:"+name))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "parameters"), query, "parameters")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Parameters named %q: %s", name, err)
+	}
+
+	matches := make([]cloudBoltParameter, 0, len(objects))
+	for _, object := range objects {
+		var parameter cloudBoltParameter
+		if err := json.Unmarshal(object, &parameter); err != nil {
+			return nil, err
+		}
+
+		if parameter.Name == name {
+			matches = append(matches, parameter)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find Parameter with name %q. Does the user have permission to view this?", name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	return nil, fmt.Errorf("More than one Parameter with name %q found.", name)
+}
+
+// getParameterOptions lists the allowed values of a Parameter, in a Group and Environment when they are set.
+// The error wraps cbclient.ErrNotFound when CloudBolt has no options endpoint for the Parameter.
+func getParameterOptions(apiClient *conns.CloudBoltClient, parameter *cloudBoltParameter, group string, environment string) ([]string, error) {
+	if group == "" && environment == "" {
+		return optionValues(parameter.Options), nil
+	}
+
+	optionsHref := parameter.Links.Options.Href
+	if optionsHref == "" {
+		optionsHref = strings.TrimRight(parameter.Links.Self.Href, "/") + "/options/"
+	}
+
+	query := url.Values{}
+	if group != "" {
+		query.Set("group", hrefId(group))
+	}
+	if environment != "" {
+		query.Set("environment", hrefId(environment))
+	}
+
+	var res struct {
+		Options []interface{} `json:"options"`
+	}
+	if err := apiClient.Get(optionsHref, query, &res); err != nil {
+		return nil, fmt.Errorf("Error getting Parameter (%s) options: %w", parameter.ID, err)
+	}
+
+	return optionValues(res.Options), nil
+}
+
+// optionValues converts the allowed values of a Parameter to strings, options can be values or {"value": ...} objects.
+func optionValues(options []interface{}) []string {
+	values := make([]string, 0, len(options))
+	for _, option := range options {
+		if optionMap, ok := option.(map[string]interface{}); ok {
+			option = optionMap["value"]
+		}
+		values = append(values, convertValueToPlainString(option))
+	}
+
+	return values
+}
@@ -0,0 +1,63 @@
+package cmp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+)
+
+func TestParameterConstraints(t *testing.T) {
+	var parameter cloudBoltParameter
+	err := json.Unmarshal([]byte(`{
+		"id": "CF-abcd1234",
+		"type": "STR",
+		"constraints": {"regex": "^[a-z]+$", "minLength": 3, "maxLength": 16, "minimum": null}
+	}`), &parameter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	constraints := parameter.Constraints
+	if constraints.Regex != "^[a-z]+$" {
+		t.Errorf("unexpected regex %q", constraints.Regex)
+	}
+
+	if constraints.MinLength == nil || *constraints.MinLength != 3 || constraints.MaxLength == nil || *constraints.MaxLength != 16 {
+		t.Errorf("unexpected length limits %v %v", constraints.MinLength, constraints.MaxLength)
+	}
+
+	if constraints.Minimum != nil || constraints.Maximum != nil {
+		t.Errorf("expected no value limits for a string Parameter, got %v %v", constraints.Minimum, constraints.Maximum)
+	}
+}
+
+func TestGetParameterOptions(t *testing.T) {
+	parameter := &cloudBoltParameter{
+		ID:      "CF-abcd1234",
+		Options: []interface{}{map[string]interface{}{"value": "small"}, "large"},
+	}
+	parameter.Links.Self.Href = "/api/v3/cmp/parameters/CF-abcd1234/"
+
+	if options, err := getParameterOptions(nil, parameter, "", ""); err != nil || strings.Join(options, ",") != "small,large" {
+		t.Errorf("expected the options of the Parameter itself, got %v (%v)", options, err)
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := getParameterOptions(client, parameter, "GRP-abcd1234", ""); !errors.Is(err, cbclient.ErrNotFound) {
+		t.Errorf("expected a not found error for the Group options, got %v", err)
+	}
+}
+
+func TestOptionValues(t *testing.T) {
+	options := optionValues([]interface{}{1000000.0, map[string]interface{}{"value": 2.5}, "large", true})
+	if strings.Join(options, ",") != "1000000,2.5,large,true" {
+		t.Errorf("expected numeric options without exponents, got %v", options)
+	}
+}
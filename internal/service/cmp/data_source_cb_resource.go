@@ -2,8 +2,13 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -46,12 +51,71 @@ func DataSourceCloudBoltResource() *schema.Resource {
 				Computed:    true,
 				Description: "CloudBolt Resource attributes",
 			},
+			"attributes_json": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "CloudBolt Resource attributes with each value encoded as JSON",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt Resource Type",
+			},
+			"blueprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt Blueprint the Resource was deployed from",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt Group of the Resource",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt User that owns the Resource",
+			},
+			"parent_resource": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the parent CloudBolt Resource, empty for top-level Resources",
+			},
+			"child_resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CloudBolt Resources whose parent is this Resource",
+				Elem:        referenceResource("Resource"),
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Resource Actions available on the Resource, \"name\" is the title used by Resource Action lookups",
+				Elem:        referenceResource("Resource Action"),
+			},
+			"server_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The global ids of the CloudBolt Servers of the Resource",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CloudBolt Servers of the Resource",
+				Elem: &schema.Resource{
+					Schema: serverItemSchema(),
+				},
+			},
 		},
 	}
 }
 
 func dataSourceCloudBoltResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	id := d.Get("id").(string)
 	name := d.Get("name").(string)
 	urlPath := d.Get("url_path").(string)
@@ -65,7 +129,7 @@ func dataSourceCloudBoltResourceRead(ctx context.Context, d *schema.ResourceData
 	if urlPath != "" {
 		resource, err = apiClient.GetResource(urlPath)
 	} else if name != "" {
-		resource, err = apiClient.GetResourceByName(name)
+		resource, err = getResourceByName(apiClient, name)
 	} else {
 		resource, err = apiClient.GetResourceById(id)
 	}
@@ -86,5 +150,131 @@ func dataSourceCloudBoltResourceRead(ctx context.Context, d *schema.ResourceData
 	resAttributes, _ := parseAttributes(resource.Attributes)
 	d.Set("attributes", resAttributes)
 
+	resAttributesJSON, err := parseAttributesJSON(resource.Attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("attributes_json", resAttributesJSON)
+
+	d.Set("resource_type", resource.Links.ResourceType.Href)
+	d.Set("blueprint", resource.Links.Blueprint.Href)
+	d.Set("group", resource.Links.Group.Href)
+	d.Set("owner", resource.Links.Owner.Href)
+	d.Set("parent_resource", resource.Links.ParentResource.Href)
+
+	actions := make([]map[string]interface{}, 0, len(resource.Links.Actions))
+	for _, action := range resource.Links.Actions {
+		actions = append(actions, halReference(action))
+	}
+	d.Set("actions", actions)
+
+	serverIds := make([]string, 0, len(resource.Links.Servers))
+	servers := make([]map[string]interface{}, 0, len(resource.Links.Servers))
+	for _, link := range resource.Links.Servers {
+		svr, err := apiClient.GetServer(link.Href)
+		if err != nil {
+			return diag.Errorf("Error getting Server (%s): %s", link.Href, err)
+		}
+
+		server, err := parseServerItem(svr)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		serverIds = append(serverIds, svr.ID)
+		servers = append(servers, server)
+	}
+	d.Set("server_ids", serverIds)
+	d.Set("servers", servers)
+
+	childResources, err := getChildResources(apiClient, resource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("child_resources", childResources)
+
 	return nil
 }
+
+// getResourceByName finds the single active Resource with a name, and lists the candidates when there is more than one.
+func getResourceByName(apiClient *conns.CloudBoltClient, name string) (*cbclient.CloudBoltResource, error) {
+	query := url.Values{}
+	query.Set("filter", conns.Filter("name:"+name, "status:ACTIVE"))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "resources"), query, "resources")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Resources named %q: %s", name, err)
+	}
+
+	matches := make([]cbclient.CloudBoltResource, 0, len(objects))
+	for _, object := range objects {
+		var res cbclient.CloudBoltResource
+		if err := json.Unmarshal(object, &res); err != nil {
+			return nil, err
+		}
+
+		if res.Name == name && !strings.EqualFold(res.Status, historicalResourceStatus) {
+			matches = append(matches, res)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find Resource with name %q. Does the user have permission to view this?", name)
+	case 1:
+		// The list only holds a summary of the Resource, its servers, actions and attributes are read from the details.
+		return apiClient.GetResource(matches[0].Links.Self.Href)
+	}
+
+	return nil, fmt.Errorf("%d Resources named %q found, use \"id\" or \"url_path\" to choose one of:\n%s", len(matches), name, resourceCandidates(matches))
+}
+
+// resourceCandidates describes each Resource on its own line, to tell apart Resources with the same name.
+func resourceCandidates(resources []cbclient.CloudBoltResource) string {
+	lines := make([]string, 0, len(resources))
+	for _, res := range resources {
+		line := fmt.Sprintf("  - %s (%s), created %s", res.ID, res.Links.Self.Href, res.Created)
+		if res.Links.Group.Title != "" {
+			line += fmt.Sprintf(", group %q", res.Links.Group.Title)
+		}
+		if res.Links.Blueprint.Title != "" {
+			line += fmt.Sprintf(", blueprint %q", res.Links.Blueprint.Title)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// getChildResources lists the Resources whose parent is the given Resource.
+func getChildResources(apiClient *conns.CloudBoltClient, resource *cbclient.CloudBoltResource) ([]map[string]interface{}, error) {
+	query := url.Values{}
+	query.Set("filter", conns.Filter("parent_resource.id:"+resource.ID))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "resources"), query, "resources")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing child Resources of Resource (%s): %s", resource.ID, err)
+	}
+
+	children := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		var child cbclient.CloudBoltResource
+		if err := json.Unmarshal(object, &child); err != nil {
+			return nil, err
+		}
+
+		// Older CloudBolt versions ignore unknown filters, so the parent is checked again here.
+		if !equivalentHref(child.Links.ParentResource.Href, resource.ID) {
+			continue
+		}
+
+		children = append(children, map[string]interface{}{
+			"id":       child.ID,
+			"name":     child.Name,
+			"url_path": child.Links.Self.Href,
+		})
+	}
+
+	return children, nil
+}
@@ -2,8 +2,13 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +33,71 @@ func DataSourceCloudBoltResourceHandler() *schema.Resource {
 				Computed:    true,
 				Description: "The relative API URL path for the CloudBolt Resource Handler.",
 			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Resource Handler type as reported by CloudBolt, e.g. \"AWS resource handler\"",
+			},
+			"technology": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Resource Handler technology, e.g. \"aws\", \"azure_arm\", \"gcp\"",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The host or endpoint of the Resource Handler, empty for public clouds without one",
+			},
+			"port": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port of the Resource Handler endpoint",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol of the Resource Handler endpoint",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The regions of the Environments backed by the Resource Handler",
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Environments backed by the Resource Handler",
+				Elem:        referenceResource("Environment"),
+			},
+			"last_sync": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time CloudBolt synchronized the Resource Handler, empty if unknown",
+			},
 		},
 	}
 }
 
+// cloudBoltResourceHandlerDetails holds the Resource Handler fields cbclient.CloudBoltReferenceFields does not.
+type cloudBoltResourceHandlerDetails struct {
+	Type         string      `json:"type"`
+	TechTypeSlug string      `json:"techTypeSlug"`
+	IP           string      `json:"ip"`
+	Port         interface{} `json:"port"`
+	Protocol     string      `json:"protocol"`
+	LastSync     string      `json:"lastSync"`
+}
+
+// resourceHandlerTechnologies maps the technologies derived from a Resource Handler type to the CloudBolt
+// slugs where they differ.
+var resourceHandlerTechnologies = map[string]string{
+	"google_cloud_platform": "gcp",
+	"amazon_web_services":   "aws",
+}
+
 func dataSourceCloudBoltResourceHandlerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 	id := d.Get("id").(string)
 
@@ -60,5 +124,77 @@ func dataSourceCloudBoltResourceHandlerRead(ctx context.Context, d *schema.Resou
 		d.Set("name", rh.Name)
 	}
 
+	var details cloudBoltResourceHandlerDetails
+	if err := apiClient.Get(rh.Links.Self.Href, nil, &details); err != nil {
+		return diag.Errorf("Error getting Resource Handler (%s) details: %s", rh.ID, err)
+	}
+
+	technology := details.TechTypeSlug
+	if technology == "" {
+		technology = resourceHandlerTechnology(details.Type)
+	}
+
+	query := url.Values{}
+	query.Set("filter", conns.Filter("resource_handler.id:"+rh.ID))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "environments"), query, "environments")
+	if err != nil {
+		return diag.Errorf("Error listing Environments: %s", err)
+	}
+
+	environments := make([]map[string]interface{}, 0)
+	regions := make([]string, 0)
+	for _, object := range objects {
+		var env struct {
+			cbclient.CloudBoltReferenceFields
+			Links struct {
+				Self            cbclient.CloudBoltHALItem `json:"self"`
+				ResourceHandler cbclient.CloudBoltHALItem `json:"resourceHandler"`
+			} `json:"_links"`
+			Region string `json:"region"`
+		}
+		if err := json.Unmarshal(object, &env); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !equivalentHref(env.Links.ResourceHandler.Href, rh.Links.Self.Href) {
+			continue
+		}
+
+		environments = append(environments, map[string]interface{}{
+			"id":       env.ID,
+			"name":     env.Name,
+			"url_path": env.Links.Self.Href,
+		})
+
+		if env.Region != "" && !containsString(regions, env.Region) {
+			regions = append(regions, env.Region)
+		}
+	}
+	sort.Strings(regions)
+
+	d.Set("type", details.Type)
+	d.Set("technology", technology)
+	d.Set("host", details.IP)
+	d.Set("port", convertValueToString(details.Port))
+	d.Set("protocol", details.Protocol)
+	d.Set("regions", regions)
+	d.Set("environments", environments)
+	d.Set("last_sync", details.LastSync)
+
 	return nil
 }
+
+// resourceHandlerTechnology derives a technology slug from a Resource Handler type when CloudBolt does not
+// report one, e.g. "AWS resource handler" -> "aws", "Google Cloud Platform" -> "gcp".
+func resourceHandlerTechnology(rhType string) string {
+	technology := strings.ToLower(strings.TrimSpace(rhType))
+	technology = strings.TrimSpace(strings.TrimSuffix(technology, "resource handler"))
+	technology = strings.Join(strings.Fields(technology), "_")
+
+	if slug, ok := resourceHandlerTechnologies[technology]; ok {
+		return slug
+	}
+
+	return technology
+}
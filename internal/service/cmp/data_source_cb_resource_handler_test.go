@@ -0,0 +1,16 @@
+package cmp
+
+import "testing"
+
+func TestResourceHandlerTechnology(t *testing.T) {
+	for rhType, expected := range map[string]string{
+		"AWS resource handler":       "aws",
+		"Amazon Web Services":        "aws",
+		"Azure ARM resource handler": "azure_arm",
+		"Google Cloud Platform":      "gcp",
+	} {
+		if technology := resourceHandlerTechnology(rhType); technology != expected {
+			t.Errorf("%s: expected %q, got %q", rhType, expected, technology)
+		}
+	}
+}
@@ -2,11 +2,18 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"path"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceCloudBoltResourceJobs() *schema.Resource {
@@ -24,12 +31,55 @@ func DataSourceCloudBoltResourceJobs() *schema.Resource {
 				Optional:    true,
 				Description: "The relative API URL path for the CloudBolt Resource, required if \"id\" is not provided",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Jobs with this status, e.g. \"SUCCESS\"",
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only Jobs with a title matching this regular expression",
+			},
+			"started_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Only Jobs started at or after this date, e.g. \"2026-01-01\" or \"2026-01-01T00:00:00\"",
+			},
+			"started_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Only Jobs started before this date, e.g. \"2026-02-01\" or \"2026-02-01T00:00:00\"",
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only return the most recently started matching Job",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Only return the most recently started matching Jobs, up to this number",
+			},
 			"job_info": {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Job information for the CloudBolt Resource",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"title": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -67,7 +117,7 @@ func DataSourceCloudBoltResourceJobs() *schema.Resource {
 }
 
 func dataSourceCloudBoltResourceJobsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	id := d.Get("id").(string)
 	urlPath := d.Get("url_path").(string)
 
@@ -75,15 +125,18 @@ func dataSourceCloudBoltResourceJobsRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Either id or url_path is required")
 	}
 
-	var resourceJobInfo *cbclient.CloudBoltResourceJobInfo
-	var err error
+	jobInfoPath := conns.APIEndpoint("cmp", "resources", id, "jobsInfo")
 	if urlPath != "" {
-		jobInfoPath := strings.TrimRight(urlPath, "/") + "/jobsInfo/"
-		resourceJobInfo, err = apiClient.GetResourceJobInfo(jobInfoPath)
-	} else {
-		resourceJobInfo, err = apiClient.GetResourceJobInfoById(id)
+		jobInfoPath = strings.TrimRight(urlPath, "/") + "/jobsInfo/"
+	}
+
+	// jobsInfo is read directly because cbclient.CloudBoltResourceJobInfo does not hold the job id and type.
+	var raw json.RawMessage
+	if err := apiClient.Get(jobInfoPath, nil, &raw); err != nil {
+		return diag.FromErr(err)
 	}
 
+	jobInfoList, err := parseResourceJobInfo(raw)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,9 +147,50 @@ func dataSourceCloudBoltResourceJobsRead(ctx context.Context, d *schema.Resource
 		d.SetId(urlPath)
 	}
 
-	jobInfoList := make([]map[string]interface{}, len(*resourceJobInfo))
-	for i, job := range *resourceJobInfo {
+	d.Set("job_info", filterResourceJobInfo(d, jobInfoList))
+
+	return nil
+}
+
+// parseResourceJobInfo converts the jobsInfo of a Resource to "job_info" blocks.
+func parseResourceJobInfo(raw json.RawMessage) ([]map[string]interface{}, error) {
+	var resourceJobInfo cbclient.CloudBoltResourceJobInfo
+	if err := json.Unmarshal(raw, &resourceJobInfo); err != nil {
+		return nil, err
+	}
+
+	var jobRefs []struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		JobType string `json:"jobType"`
+		Href    string `json:"href"`
+		Links   struct {
+			Self cbclient.CloudBoltHALItem `json:"self"`
+		} `json:"_links"`
+	}
+	if err := json.Unmarshal(raw, &jobRefs); err != nil {
+		return nil, err
+	}
+
+	jobInfoList := make([]map[string]interface{}, len(resourceJobInfo))
+	for i, job := range resourceJobInfo {
+		jobRef := jobRefs[i]
+
+		jobId := jobRef.ID
+		for _, href := range []string{jobRef.Links.Self.Href, jobRef.Href} {
+			if jobId == "" && href != "" {
+				jobId = path.Base(strings.TrimRight(href, "/"))
+			}
+		}
+
+		jobType := jobRef.Type
+		if jobType == "" {
+			jobType = jobRef.JobType
+		}
+
 		jobInfoList[i] = map[string]interface{}{
+			"id":                jobId,
+			"type":              jobType,
 			"title":             job.Title,
 			"start_date":        job.StartDate,
 			"end_date":          job.EndDate,
@@ -106,7 +200,72 @@ func dataSourceCloudBoltResourceJobsRead(ctx context.Context, d *schema.Resource
 			"progress_messages": job.ProgressMessages,
 		}
 	}
-	d.Set("job_info", jobInfoList)
 
-	return nil
+	return jobInfoList, nil
+}
+
+// filterResourceJobInfo applies the data source filters to "job_info" blocks.
+// When most_recent or limit is set the Jobs are returned newest first, otherwise in the API order.
+func filterResourceJobInfo(d *schema.ResourceData, jobInfoList []map[string]interface{}) []map[string]interface{} {
+	status := d.Get("status").(string)
+
+	var titleRegex *regexp.Regexp
+	if v := d.Get("title").(string); v != "" {
+		titleRegex = regexp.MustCompile(v)
+	}
+
+	var startedAfter, startedBefore time.Time
+	if v := d.Get("started_after").(string); v != "" {
+		startedAfter, _ = parseDate(v)
+	}
+	if v := d.Get("started_before").(string); v != "" {
+		startedBefore, _ = parseDate(v)
+	}
+
+	filtered := make([]map[string]interface{}, 0, len(jobInfoList))
+	startDates := make(map[int]time.Time)
+	for _, job := range jobInfoList {
+		if status != "" && !strings.EqualFold(job["status"].(string), status) {
+			continue
+		}
+
+		if titleRegex != nil && !titleRegex.MatchString(job["title"].(string)) {
+			continue
+		}
+
+		startDate, ok := dateInRange(job["start_date"].(string), startedAfter, startedBefore)
+		if !ok {
+			continue
+		}
+
+		startDates[len(filtered)] = startDate
+		filtered = append(filtered, job)
+	}
+
+	limit := d.Get("limit").(int)
+	if d.Get("most_recent").(bool) {
+		limit = 1
+	}
+
+	if limit == 0 {
+		return filtered
+	}
+
+	indexes := make([]int, len(filtered))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return startDates[indexes[i]].After(startDates[indexes[j]])
+	})
+
+	newest := make([]map[string]interface{}, 0, limit)
+	for _, i := range indexes {
+		if len(newest) == limit {
+			break
+		}
+		newest = append(newest, filtered[i])
+	}
+
+	return newest
 }
@@ -0,0 +1,79 @@
+package cmp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFilterResourceJobInfo(t *testing.T) {
+	raw := json.RawMessage(`[
+		{"id": "JOB-1", "type": "resource_action", "title": "Backup", "status": "SUCCESS", "startDate": "2026-01-01 10:00:00"},
+		{"_links": {"self": {"href": "/api/v3/cmp/jobs/JOB-2/"}}, "title": "Backup", "status": "FAILURE", "startDate": "2026-01-03 10:00:00"},
+		{"id": "JOB-3", "title": "Backup", "status": "SUCCESS", "startDate": "2026-01-02 10:00:00"},
+		{"id": "JOB-4", "title": "Resize", "status": "SUCCESS", "startDate": "2026-01-04 10:00:00"}
+	]`)
+
+	jobInfoList, err := parseResourceJobInfo(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if jobInfoList[0]["type"] != "resource_action" || jobInfoList[1]["id"] != "JOB-2" {
+		t.Errorf("unexpected job references %v", jobInfoList)
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltResourceJobs().Schema, map[string]interface{}{
+		"id":          "RSC-abcd1234",
+		"status":      "success",
+		"title":       "^Backup$",
+		"most_recent": true,
+	})
+
+	filtered := filterResourceJobInfo(d, jobInfoList)
+	if len(filtered) != 1 || filtered[0]["id"] != "JOB-3" {
+		t.Errorf("expected the most recent successful backup JOB-3, got %v", filtered)
+	}
+
+	d = schema.TestResourceDataRaw(t, DataSourceCloudBoltResourceJobs().Schema, map[string]interface{}{
+		"id":             "RSC-abcd1234",
+		"started_after":  "2026-01-02",
+		"started_before": "2026-01-04",
+	})
+
+	filtered = filterResourceJobInfo(d, jobInfoList)
+	if len(filtered) != 2 || filtered[0]["id"] != "JOB-2" || filtered[1]["id"] != "JOB-3" {
+		t.Errorf("expected JOB-2 and JOB-3 in API order, got %v", filtered)
+	}
+}
+
+func TestDateInRange(t *testing.T) {
+	after, _ := parseDate("2026-01-02")
+	before, _ := parseDate("2026-01-04")
+
+	tests := []struct {
+		value  string
+		after  time.Time
+		before time.Time
+		want   bool
+	}{
+		{value: "2026-01-02 00:00:00", after: after, before: before, want: true},
+		{value: "2026-01-03T12:00:00Z", after: after, before: before, want: true},
+		{value: "2026-01-01 23:59:59", after: after, want: false},
+		{value: "2026-01-04", before: before, want: false},
+		{value: "", want: true},
+		{value: "", after: after, want: false},
+	}
+
+	for _, tt := range tests {
+		if _, got := dateInRange(tt.value, tt.after, tt.before); got != tt.want {
+			t.Errorf("dateInRange(%q, %v, %v) = %v, want %v", tt.value, tt.after, tt.before, got, tt.want)
+		}
+	}
+
+	if _, errs := validateDate("yesterday", "started_after"); len(errs) != 1 {
+		t.Errorf("expected started_after \"yesterday\" to be rejected, got %v", errs)
+	}
+}
@@ -0,0 +1,137 @@
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetChildResources(t *testing.T) {
+	var filter string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		filter = r.URL.Query().Get("filter")
+		fmt.Fprint(w, `{"total": 2, "_embedded": {"resources": [
+			{"id": "RSC-child001", "name": "web-lb", "_links": {"self": {"href": "/api/v3/cmp/resources/RSC-child001/"}, "parentResource": {"href": "/api/v3/cmp/resources/RSC-abcd1234/"}}},
+			{"id": "RSC-other001", "name": "db", "_links": {"self": {"href": "/api/v3/cmp/resources/RSC-other001/"}, "parentResource": {"href": "/api/v3/cmp/resources/RSC-efgh5678/"}}}
+		]}}`)
+	})
+
+	resource := &cbclient.CloudBoltResource{}
+	resource.ID = "RSC-abcd1234"
+
+	children, err := getChildResources(client, resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filter != "parent_resource.id:RSC-abcd1234" {
+		t.Errorf("unexpected filter %q", filter)
+	}
+
+	if len(children) != 1 || children[0]["id"] != "RSC-child001" || children[0]["name"] != "web-lb" || children[0]["url_path"] != "/api/v3/cmp/resources/RSC-child001/" {
+		t.Errorf("expected only the Resource with the parent to be listed, got %v", children)
+	}
+}
+
+func TestDataSourceCloudBoltResourceRead(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/cmp/resources/RSC-abcd1234/":
+			fmt.Fprint(w, `{
+				"id": "RSC-abcd1234",
+				"name": "web",
+				"status": "ACTIVE",
+				"_links": {
+					"self": {"href": "/api/v3/cmp/resources/RSC-abcd1234/"},
+					"actions": [{"href": "/api/v3/cmp/resourceActions/RSA-1/", "title": "Scale"}],
+					"servers": [{"href": "/api/v3/cmp/servers/SVR-abcd1234/"}]
+				}
+			}`)
+		case "/api/v3/cmp/servers/SVR-abcd1234/":
+			fmt.Fprint(w, `{
+				"id": "SVR-abcd1234",
+				"hostname": "web01",
+				"ipAddress": "10.0.0.5",
+				"_links": {
+					"self": {"href": "/api/v3/cmp/servers/SVR-abcd1234/"},
+					"group": {"href": "/api/v3/cmp/groups/GRP-abcd1234/"},
+					"environment": {"href": "/api/v3/cmp/environments/ENV-abcd1234/"}
+				}
+			}`)
+		case "/api/v3/cmp/resources/":
+			fmt.Fprint(w, `{"total": 0, "_embedded": {"resources": []}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltResource().Schema, map[string]interface{}{"id": "RSC-abcd1234"})
+	if diags := dataSourceCloudBoltResourceRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	actions := d.Get("actions").([]interface{})
+	if len(actions) != 1 {
+		t.Fatalf("expected 1 action, got %v", actions)
+	}
+	if action := actions[0].(map[string]interface{}); action["id"] != "RSA-1" || action["name"] != "Scale" || action["url_path"] != "/api/v3/cmp/resourceActions/RSA-1/" {
+		t.Errorf("unexpected action %v", action)
+	}
+
+	if serverIds := d.Get("server_ids").([]interface{}); len(serverIds) != 1 || serverIds[0] != "SVR-abcd1234" {
+		t.Errorf("unexpected server ids %v", serverIds)
+	}
+
+	servers := d.Get("servers").([]interface{})
+	if len(servers) != 1 {
+		t.Fatalf("expected 1 server, got %v", servers)
+	}
+	if server := servers[0].(map[string]interface{}); server["hostname"] != "web01" || server["ip_address"] != "10.0.0.5" || server["group"] != "/api/v3/cmp/groups/GRP-abcd1234/" || server["environment"] != "/api/v3/cmp/environments/ENV-abcd1234/" {
+		t.Errorf("unexpected server %v", server)
+	}
+
+	if children := d.Get("child_resources").([]interface{}); len(children) != 0 {
+		t.Errorf("expected no child resources, got %v", children)
+	}
+}
+
+func TestGetResourceByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/cmp/resources/":
+			if filter := r.URL.Query().Get("filter"); filter != "name:web;status:ACTIVE" {
+				t.Errorf("unexpected filter %q", filter)
+			}
+			fmt.Fprint(w, `{"total": 1, "_embedded": {"resources": [
+				{"id": "RSC-abcd1234", "name": "web", "status": "ACTIVE", "_links": {"self": {"href": "/api/v3/cmp/resources/RSC-abcd1234/"}}}
+			]}}`)
+		case "/api/v3/cmp/resources/RSC-abcd1234/":
+			fmt.Fprint(w, `{
+				"id": "RSC-abcd1234",
+				"name": "web",
+				"status": "ACTIVE",
+				"_links": {
+					"self": {"href": "/api/v3/cmp/resources/RSC-abcd1234/"},
+					"servers": [{"href": "/api/v3/cmp/servers/SVR-abcd1234/"}]
+				}
+			}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	resource, err := getResourceByName(client, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resource.ID != "RSC-abcd1234" || len(resource.Links.Servers) != 1 {
+		t.Errorf("expected the Resource details to be fetched, got %+v", resource)
+	}
+}
@@ -0,0 +1,257 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// historicalResourceStatus is the status CloudBolt gives deleted Resources.
+const historicalResourceStatus = "HISTORICAL"
+
+func DataSourceCloudBoltResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Resources of this CloudBolt Resource Type, as a relative API URL path, global id or name",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Resources in this CloudBolt Group, as a relative API URL path or global id",
+			},
+			"blueprint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Resources deployed from this CloudBolt Blueprint, as a relative API URL path or global id",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Resources with this status, e.g. \"ACTIVE\"",
+			},
+			"include_historical": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether deleted Resources, with status \"HISTORICAL\", are returned when \"status\" is not set",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only Resources whose attributes equal every Name/Value pair",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The global ids of the matching CloudBolt Resources",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching CloudBolt Resources, sorted by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The global id of the CloudBolt Resource",
+						},
+						"url_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt Resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the CloudBolt Resource",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CloudBolt Resource Status",
+						},
+						"create_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date the CloudBolt Resource was created",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt Resource Type",
+						},
+						"blueprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt Blueprint the Resource was deployed from",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt Group of the Resource",
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt User that owns the Resource",
+						},
+						"parent_resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the parent CloudBolt Resource, empty for top-level Resources",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "CloudBolt Resource attributes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudBoltResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	query := url.Values{}
+	if filter := resourcesFilter(d); filter != "" {
+		query.Set("filter", filter)
+	}
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "resources"), query, "resources")
+	if err != nil {
+		return diag.Errorf("Error listing Resources: %s", err)
+	}
+
+	resources := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		var res cbclient.CloudBoltResource
+		if err := json.Unmarshal(object, &res); err != nil {
+			return diag.FromErr(err)
+		}
+
+		resAttributes, err := parseAttributes(res.Attributes)
+		if err != nil {
+			return diag.Errorf("Error parsing Resource (%s) attributes: %s", res.ID, err)
+		}
+
+		if !resourceMatches(&res, resAttributes, d) {
+			continue
+		}
+
+		resources = append(resources, map[string]interface{}{
+			"id":              res.ID,
+			"url_path":        res.Links.Self.Href,
+			"name":            res.Name,
+			"status":          res.Status,
+			"create_date":     res.Created,
+			"resource_type":   res.Links.ResourceType.Href,
+			"blueprint":       res.Links.Blueprint.Href,
+			"group":           res.Links.Group.Href,
+			"owner":           res.Links.Owner.Href,
+			"parent_resource": res.Links.ParentResource.Href,
+			"attributes":      resAttributes,
+		})
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i]["name"].(string) != resources[j]["name"].(string) {
+			return resources[i]["name"].(string) < resources[j]["name"].(string)
+		}
+
+		return resources[i]["id"].(string) < resources[j]["id"].(string)
+	})
+
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource["id"].(string))
+	}
+
+	d.SetId(listDataSourceID(ids))
+	d.Set("ids", ids)
+	d.Set("resources", resources)
+
+	return nil
+}
+
+// resourcesFilter builds the API filter for the data source arguments, so only the matching Resources are listed.
+// Names and status use iexact lookups, so the API matches them case-insensitively like resourceMatches,
+// which still checks the listed Resources, e.g. the attributes are only compared locally.
+func resourcesFilter(d *schema.ResourceData) string {
+	conds := make([]string, 0)
+
+	// A Resource Type is given by name unless it is an API URL path or global id.
+	if resourceType := d.Get("resource_type").(string); strings.HasPrefix(resourceType, "/") || strings.HasPrefix(resourceType, "RT-") {
+		conds = append(conds, "resource_type.id:"+hrefId(resourceType))
+	} else if resourceType != "" {
+		conds = append(conds, "resource_type.name.iexact:"+resourceType)
+	}
+
+	if group := d.Get("group").(string); group != "" {
+		conds = append(conds, "group.id:"+hrefId(group))
+	}
+
+	if blueprint := d.Get("blueprint").(string); blueprint != "" {
+		conds = append(conds, "blueprint.id:"+hrefId(blueprint))
+	}
+
+	if status := d.Get("status").(string); status != "" {
+		conds = append(conds, "status.iexact:"+status)
+	} else if !d.Get("include_historical").(bool) {
+		conds = append(conds, "status.ne:"+historicalResourceStatus)
+	}
+
+	return conns.Filter(conds...)
+}
+
+// resourceMatches applies the data source filters to a Resource.
+func resourceMatches(res *cbclient.CloudBoltResource, resAttributes map[string]interface{}, d *schema.ResourceData) bool {
+	if resourceType := d.Get("resource_type").(string); resourceType != "" {
+		if !equivalentHref(res.Links.ResourceType.Href, resourceType) && !strings.EqualFold(res.Links.ResourceType.Title, resourceType) {
+			return false
+		}
+	}
+
+	if group := d.Get("group").(string); group != "" && !equivalentHref(res.Links.Group.Href, group) {
+		return false
+	}
+
+	if blueprint := d.Get("blueprint").(string); blueprint != "" && !equivalentHref(res.Links.Blueprint.Href, blueprint) {
+		return false
+	}
+
+	if status := d.Get("status").(string); status != "" {
+		if !strings.EqualFold(res.Status, status) {
+			return false
+		}
+	} else if !d.Get("include_historical").(bool) && strings.EqualFold(res.Status, historicalResourceStatus) {
+		return false
+	}
+
+	for k, v := range d.Get("attributes").(map[string]interface{}) {
+		value, ok := resAttributes[k]
+		if !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
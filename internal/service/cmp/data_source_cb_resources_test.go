@@ -0,0 +1,111 @@
+package cmp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceMatches(t *testing.T) {
+	var resources []cbclient.CloudBoltResource
+	err := json.Unmarshal([]byte(`[
+		{
+			"id": "RSC-1", "name": "web", "status": "ACTIVE",
+			"_links": {
+				"resourceType": {"href": "/api/v3/cmp/resourceTypes/RT-1/", "title": "service"},
+				"group": {"href": "/api/v3/cmp/groups/GRP-1/"},
+				"blueprint": {"href": "/api/v3/cmp/blueprints/BP-1/"}
+			},
+			"attributes": [{"name": "env", "value": "prod"}]
+		},
+		{
+			"id": "RSC-2", "name": "web", "status": "HISTORICAL",
+			"_links": {
+				"resourceType": {"href": "/api/v3/cmp/resourceTypes/RT-1/", "title": "service"},
+				"group": {"href": "/api/v3/cmp/groups/GRP-1/"}
+			},
+			"attributes": [{"name": "env", "value": "prod"}]
+		}
+	]`), &resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := func(raw map[string]interface{}) []string {
+		d := schema.TestResourceDataRaw(t, DataSourceCloudBoltResources().Schema, raw)
+
+		ids := make([]string, 0)
+		for i := range resources {
+			resAttributes, _ := parseAttributes(resources[i].Attributes)
+			if resourceMatches(&resources[i], resAttributes, d) {
+				ids = append(ids, resources[i].ID)
+			}
+		}
+
+		return ids
+	}
+
+	if ids := match(map[string]interface{}{"resource_type": "Service", "group": "GRP-1"}); strings.Join(ids, ",") != "RSC-1" {
+		t.Errorf("expected only the active RSC-1, got %v", ids)
+	}
+
+	if ids := match(map[string]interface{}{"include_historical": true, "attributes": map[string]interface{}{"env": "prod"}}); strings.Join(ids, ",") != "RSC-1,RSC-2" {
+		t.Errorf("expected RSC-1 and RSC-2, got %v", ids)
+	}
+
+	if ids := match(map[string]interface{}{"status": "HISTORICAL"}); strings.Join(ids, ",") != "RSC-2" {
+		t.Errorf("expected only the historical RSC-2, got %v", ids)
+	}
+
+	if ids := match(map[string]interface{}{"blueprint": "/api/v3/cmp/blueprints/BP-1/", "attributes": map[string]interface{}{"env": "dev"}}); len(ids) != 0 {
+		t.Errorf("expected no match on a different attribute value, got %v", ids)
+	}
+}
+
+func TestResourceCandidates(t *testing.T) {
+	var res cbclient.CloudBoltResource
+	res.ID = "RSC-1"
+	res.Created = "2026-01-01 10:00:00"
+	res.Links.Self.Href = "/api/v3/cmp/resources/RSC-1/"
+	res.Links.Group.Title = "Team A"
+
+	candidates := resourceCandidates([]cbclient.CloudBoltResource{res})
+	if candidates != `  - RSC-1 (/api/v3/cmp/resources/RSC-1/), created 2026-01-01 10:00:00, group "Team A"` {
+		t.Errorf("unexpected candidates %q", candidates)
+	}
+}
+
+func TestResourcesFilter(t *testing.T) {
+	tests := []struct {
+		raw  map[string]interface{}
+		want string
+	}{
+		{
+			raw:  map[string]interface{}{},
+			want: "status.ne:HISTORICAL",
+		},
+		{
+			raw: map[string]interface{}{
+				"resource_type": "service",
+				"group":         "/api/v3/cmp/groups/GRP-abcd1234/",
+				"blueprint":     "BP-abcd1234",
+				"status":        "ACTIVE",
+			},
+			want: "resource_type.name.iexact:service;group.id:GRP-abcd1234;blueprint.id:BP-abcd1234;status.iexact:ACTIVE",
+		},
+		{
+			raw:  map[string]interface{}{"resource_type": "/api/v3/cmp/resourceTypes/RT-abcd1234/", "include_historical": true},
+			want: "resource_type.id:RT-abcd1234",
+		},
+	}
+
+	for _, tt := range tests {
+		d := schema.TestResourceDataRaw(t, DataSourceCloudBoltResources().Schema, tt.raw)
+		if got := resourcesFilter(d); got != tt.want {
+			t.Errorf("resourcesFilter(%v) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
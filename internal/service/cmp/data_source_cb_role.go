@@ -0,0 +1,175 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltRoleRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The global id of a CloudBolt Role, required if \"name\" or \"url_path\" is not provided",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of a CloudBolt Role, e.g. \"approver\", required if \"id\" or \"url_path\" is not provided",
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The relative API URL path for the CloudBolt Role, required if \"id\" or \"name\" is not provided",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of the Role shown in CloudBolt, e.g. \"Approver\"",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the Role",
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the permissions the Role grants",
+			},
+		},
+	}
+}
+
+// cloudBoltRole is a Role as returned by the API.
+type cloudBoltRole struct {
+	Links struct {
+		Self cbclient.CloudBoltHALItem `json:"self"`
+	} `json:"_links"`
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Label       string        `json:"label"`
+	Description string        `json:"description"`
+	Permissions []interface{} `json:"permissions"`
+}
+
+func dataSourceCloudBoltRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	urlPath := d.Get("url_path").(string)
+
+	if id == "" && name == "" && urlPath == "" {
+		return diag.Errorf("Either id, name, or url_path is required")
+	}
+
+	if urlPath == "" && id != "" {
+		urlPath = conns.APIEndpoint("cloudbolt", "roles", id)
+	}
+
+	var role *cloudBoltRole
+	var err error
+	if urlPath != "" {
+		role = &cloudBoltRole{}
+		if err = apiClient.Get(urlPath, nil, role); err != nil {
+			return diag.Errorf("Error getting Role (%s): %s", urlPath, err)
+		}
+	} else {
+		role, err = getRoleByName(apiClient, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		if permissionMap, ok := permission.(map[string]interface{}); ok {
+			permission = permissionMap["name"]
+		}
+		permissions = append(permissions, convertValueToString(permission))
+	}
+
+	d.SetId(role.ID)
+	d.Set("name", role.Name)
+	d.Set("url_path", role.Links.Self.Href)
+	d.Set("label", role.Label)
+	d.Set("description", role.Description)
+	d.Set("permissions", permissions)
+
+	return nil
+}
+
+// getRoleByName finds the Role with a name, or failing that the single Role with a label, e.g. "Approver".
+func getRoleByName(apiClient *conns.CloudBoltClient, name string) (*cloudBoltRole, error) {
+	roles, err := listRoles(apiClient, "name:"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+
+	roles, err = listRoles(apiClient, "label:"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	labelMatches := make([]cloudBoltRole, 0, len(roles))
+	for _, role := range roles {
+		if role.Label == name {
+			labelMatches = append(labelMatches, role)
+		}
+	}
+
+	switch len(labelMatches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find Role with name %q. Does the user have permission to view this?", name)
+	case 1:
+		return &labelMatches[0], nil
+	}
+
+	candidates := make([]string, 0, len(labelMatches))
+	for _, role := range labelMatches {
+		candidates = append(candidates, fmt.Sprintf("  - %s %s (%s)", role.ID, role.Name, role.Links.Self.Href))
+	}
+
+	return nil, fmt.Errorf("%d Roles with label %q found, use \"name\" or \"id\" to choose one of:\n%s", len(labelMatches), name, strings.Join(candidates, "\n"))
+}
+
+// listRoles lists the Roles matching an API filter.
+func listRoles(apiClient *conns.CloudBoltClient, filter string) ([]cloudBoltRole, error) {
+	query := url.Values{}
+	query.Set("filter", filter)
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cloudbolt", "roles"), query, "roles")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Roles with %s: %s", filter, err)
+	}
+
+	roles := make([]cloudBoltRole, 0, len(objects))
+	for _, object := range objects {
+		var role cloudBoltRole
+		if err := json.Unmarshal(object, &role); err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
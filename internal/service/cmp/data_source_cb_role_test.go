@@ -0,0 +1,72 @@
+package cmp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetRoleByName(t *testing.T) {
+	roles := []map[string]interface{}{
+		{"id": "ROLE-1", "name": "approver", "label": "Approver", "_links": map[string]interface{}{"self": map[string]interface{}{"href": "/api/v3/cloudbolt/roles/ROLE-1/"}}},
+		{"id": "ROLE-2", "name": "requestor", "label": "Requestor", "_links": map[string]interface{}{"self": map[string]interface{}{"href": "/api/v3/cloudbolt/roles/ROLE-2/"}}},
+		{"id": "ROLE-3", "name": "viewer", "label": "Viewer", "_links": map[string]interface{}{"self": map[string]interface{}{"href": "/api/v3/cloudbolt/roles/ROLE-3/"}}},
+		{"id": "ROLE-4", "name": "viewer_legacy", "label": "Viewer", "_links": map[string]interface{}{"self": map[string]interface{}{"href": "/api/v3/cloudbolt/roles/ROLE-4/"}}},
+	}
+
+	filters := make([]string, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		filters = append(filters, filter)
+
+		// Only the name: and label: filters are used by getRoleByName.
+		field, value, _ := strings.Cut(filter, ":")
+		matches := make([]map[string]interface{}, 0)
+		for _, role := range roles {
+			if role[field] == value {
+				matches = append(matches, role)
+			}
+		}
+
+		res, _ := json.Marshal(map[string]interface{}{"total": len(matches), "_embedded": map[string]interface{}{"roles": matches}})
+		fmt.Fprint(w, string(res))
+	})
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr string
+	}{
+		{name: "approver", want: "ROLE-1"},
+		{name: "Requestor", want: "ROLE-2"},
+		{name: "viewer", want: "ROLE-3"},
+		{name: "Viewer", wantErr: "2 Roles with label \"Viewer\" found"},
+		{name: "Owner", wantErr: "Could not find Role"},
+	}
+
+	for _, tt := range tests {
+		role, err := getRoleByName(client, tt.name)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: expected error %q, got %v", tt.name, tt.wantErr, err)
+			}
+			continue
+		}
+
+		if err != nil || role.ID != tt.want {
+			t.Errorf("%s: expected %s, got %v (%v)", tt.name, tt.want, role, err)
+		}
+	}
+
+	filters = filters[:0]
+	_, err := getRoleByName(client, "Viewer")
+	if err == nil || !strings.Contains(err.Error(), "ROLE-3 viewer") || !strings.Contains(err.Error(), "ROLE-4 viewer_legacy") {
+		t.Errorf("expected both Viewer Roles to be listed, got %v", err)
+	}
+
+	if len(filters) != 2 || filters[0] != "name:Viewer" || filters[1] != "label:Viewer" {
+		t.Errorf("expected the Roles to be filtered by name, then by label, got %v", filters)
+	}
+}
@@ -2,8 +2,14 @@ package cmp
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,22 +22,23 @@ func DataSourceCloudBoltServer() *schema.Resource {
 			"id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The global id of a CloudBolt Server, required if \"hostname\" or \"url_path\" is not provided",
+				Description: "The global id of a CloudBolt Server, required if no other lookup argument is provided",
 			},
 			"url_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The relative API URL path for the CloudBolt Server, required if \"id\" or \"hostname\" is not provided",
+				Description: "The relative API URL path for the CloudBolt Server, required if no other lookup argument is provided",
 			},
 			"hostname": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The hostname of the CloudBolt Server, required if \"id\" or \"url_path\" is not provided",
+				Description: "The hostname of the CloudBolt Server, required if no other lookup argument is provided",
 			},
 			"ip_address": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Server IP Address",
+				Description: "Server IP Address, looks up the Server by the IP Address of any of its NICs when set, otherwise the IP Address of the primary NIC",
 			},
 			"status": {
 				Type:        schema.TypeString,
@@ -40,8 +47,15 @@ func DataSourceCloudBoltServer() *schema.Resource {
 			},
 			"mac": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Server MAC Address",
+				Description: "Server MAC Address, looks up the Server by the MAC Address of any of its NICs when set, otherwise the MAC Address of the primary NIC",
+			},
+			"attribute_filter": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Look up the Server whose attributes equal every Name/Value pair, e.g. { service_tag = \"ABC123\" }",
 			},
 			"power_status": {
 				Type:        schema.TypeString,
@@ -94,6 +108,14 @@ func DataSourceCloudBoltServer() *schema.Resource {
 				},
 				Description: "CloudBolt Server attributes",
 			},
+			"attributes_json": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "CloudBolt Server attributes with each value encoded as JSON",
+			},
 			"rate_breakdown": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -130,10 +152,64 @@ func DataSourceCloudBoltServer() *schema.Resource {
 							Computed:    true,
 							Description: "Disk Size in GB",
 						},
+						"datastore": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Datastore or storage account the Disk is placed on",
+						},
+						"provisioning_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Disk provisioning type, e.g. thin or thick",
+						},
 					},
 				},
 				Description: "Server disks",
 			},
+			"nics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of NIC",
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network the NIC is attached to",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIC IP Address",
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIC MAC Address",
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIC Private IP Address",
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIC Public IP Address",
+						},
+						"primary": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this is the primary NIC of the Server",
+						},
+					},
+				},
+				Description: "Server NICs",
+			},
 			"networks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -150,13 +226,16 @@ func DataSourceCloudBoltServer() *schema.Resource {
 }
 
 func dataSourceCloudBoltServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	serverPath := d.Get("url_path").(string)
 	hostname := d.Get("hostname").(string)
 	id := d.Get("id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	mac := d.Get("mac").(string)
+	attributeFilter := d.Get("attribute_filter").(map[string]interface{})
 
-	if id == "" && hostname == "" && serverPath == "" {
-		return diag.Errorf("Either id, hostname, or url_path is required")
+	if id == "" && hostname == "" && serverPath == "" && ipAddress == "" && mac == "" && len(attributeFilter) == 0 {
+		return diag.Errorf("Either id, hostname, url_path, ip_address, mac, or attribute_filter is required")
 	}
 	var server *cbclient.CloudBoltServer
 	var err error
@@ -164,8 +243,10 @@ func dataSourceCloudBoltServerRead(ctx context.Context, d *schema.ResourceData,
 		server, err = apiClient.GetServer(serverPath)
 	} else if hostname != "" {
 		server, err = apiClient.GetServerByHostname(hostname)
-	} else {
+	} else if id != "" {
 		server, err = apiClient.GetServerById(id)
+	} else {
+		server, err = findServer(apiClient, ipAddress, mac, attributeFilter)
 	}
 
 	if err != nil {
@@ -179,9 +260,16 @@ func dataSourceCloudBoltServerRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(server.ID)
 	d.Set("url_path", server.Links.Self.Href)
 	d.Set("hostname", server.Hostname)
-	d.Set("ip_address", server.IP)
 	d.Set("status", server.Status)
-	d.Set("mac", server.Mac)
+
+	// The configured lookup values are kept, they can be those of a NIC other than the primary one.
+	if ipAddress == "" {
+		d.Set("ip_address", server.IP)
+	}
+
+	if mac == "" {
+		d.Set("mac", server.Mac)
+	}
 	d.Set("date_added_to_cloudbolt", server.DateAddedToCloudbolt)
 	d.Set("cpu_count", server.CPUCount)
 	d.Set("memory_size_gb", server.MemorySizeGB)
@@ -208,25 +296,11 @@ func dataSourceCloudBoltServerRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if len(server.Disks) > 0 {
-		disks := make([]map[string]interface{}, 0)
-
-		for _, d := range server.Disks {
-			uuid, _ := d["uuid"]
-			name, _ := d["name"]
-			disk_size_gb, _ := d["diskSize"]
-
-			disk := map[string]interface{}{
-				"uuid":         uuid,
-				"name":         name,
-				"disk_size_gb": disk_size_gb,
-			}
-			disks = append(disks, disk)
-		}
-
-		d.Set("disks", disks)
+		d.Set("disks", parseServerDisks(server.Disks))
 	}
 
 	if len(server.Networks) > 0 {
+		d.Set("nics", parseServerNICs(server.Networks))
 		d.Set("networks", server.Networks)
 	}
 
@@ -237,5 +311,168 @@ func dataSourceCloudBoltServerRead(ctx context.Context, d *schema.ResourceData,
 	svrAttributes, _ := parseAttributes(server.Attributes)
 	d.Set("attributes", svrAttributes)
 
+	svrAttributesJSON, err := parseAttributesJSON(server.Attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("attributes_json", svrAttributesJSON)
+
 	return nil
 }
+
+// findServer finds the single Server with an IP Address, MAC Address and attributes, and lists the candidates when there is more than one.
+// The list summaries do not include every NIC or the attributes, so each Server listed is fetched before it is matched.
+func findServer(apiClient *conns.CloudBoltClient, ipAddress string, mac string, attributeFilter map[string]interface{}) (*cbclient.CloudBoltServer, error) {
+	var listed []*cbclient.CloudBoltServer
+	var err error
+
+	// CloudBolt can only filter Servers on their primary NIC, every Server visible to the user is checked
+	// when that finds nothing or the lookup is by attributes only.
+	if ipAddress != "" || mac != "" {
+		listed, err = listServers(apiClient, serverLookupFilter(ipAddress, mac))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(listed) == 0 {
+		listed, err = listServers(apiClient, serverLookupFilter("", ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	matches := make([]*cbclient.CloudBoltServer, 0)
+	for _, summary := range listed {
+		svr, err := apiClient.GetServer(summary.Links.Self.Href)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting Server (%s): %s", summary.Links.Self.Href, err)
+		}
+
+		if serverLookupMatches(svr, ipAddress, mac, attributeFilter) {
+			matches = append(matches, svr)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find Server matching %s. Does the user have permission to view this?", serverLookupDescription(ipAddress, mac, attributeFilter))
+	case 1:
+		return matches[0], nil
+	}
+
+	return nil, fmt.Errorf("%d Servers matching %s found, use \"id\" or \"url_path\" to choose one of:\n%s", len(matches), serverLookupDescription(ipAddress, mac, attributeFilter), serverCandidates(matches))
+}
+
+// serverLookupFilter builds the API filter for the primary NIC of the Servers, deleted Servers are always left out.
+func serverLookupFilter(ipAddress string, mac string) string {
+	conds := []string{"status.ne:HISTORICAL"}
+
+	if ipAddress != "" {
+		conds = append(conds, "ip:"+ipAddress)
+	}
+
+	if mac != "" {
+		conds = append(conds, "mac:"+mac)
+	}
+
+	return conns.Filter(conds...)
+}
+
+// listServers lists the summaries of the Servers matching an API filter, deleted Servers are left out.
+func listServers(apiClient *conns.CloudBoltClient, filter string) ([]*cbclient.CloudBoltServer, error) {
+	query := url.Values{}
+	query.Set("filter", filter)
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "servers"), query, "servers")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Servers: %s", err)
+	}
+
+	servers := make([]*cbclient.CloudBoltServer, 0, len(objects))
+	for _, object := range objects {
+		var svr cbclient.CloudBoltServer
+		if err := json.Unmarshal(object, &svr); err != nil {
+			return nil, err
+		}
+
+		if !strings.EqualFold(svr.Status, "HISTORICAL") {
+			servers = append(servers, &svr)
+		}
+	}
+
+	return servers, nil
+}
+
+// serverLookupMatches checks a Server against the ip_address, mac and attribute_filter lookup arguments.
+func serverLookupMatches(svr *cbclient.CloudBoltServer, ipAddress string, mac string, attributeFilter map[string]interface{}) bool {
+	nics := parseServerNICs(svr.Networks)
+
+	if ipAddress != "" {
+		found := svr.IP == ipAddress
+		for _, nic := range nics {
+			found = found || nic["ip"] == ipAddress || nic["private_ip"] == ipAddress || nic["public_ip"] == ipAddress
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if mac != "" {
+		found := strings.EqualFold(svr.Mac, mac)
+		for _, nic := range nics {
+			found = found || strings.EqualFold(nic["mac"].(string), mac)
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(attributeFilter) > 0 {
+		svrAttributes, _ := parseAttributes(svr.Attributes)
+		for k, v := range attributeFilter {
+			value, ok := svrAttributes[k]
+			if !ok || value != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// serverLookupDescription describes the lookup arguments for error messages.
+func serverLookupDescription(ipAddress string, mac string, attributeFilter map[string]interface{}) string {
+	conditions := make([]string, 0)
+	if ipAddress != "" {
+		conditions = append(conditions, fmt.Sprintf("ip_address %q", ipAddress))
+	}
+
+	if mac != "" {
+		conditions = append(conditions, fmt.Sprintf("mac %q", mac))
+	}
+
+	names := make([]string, 0, len(attributeFilter))
+	for k := range attributeFilter {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		conditions = append(conditions, fmt.Sprintf("attribute %s = %q", k, convertValueToString(attributeFilter[k])))
+	}
+
+	return strings.Join(conditions, " and ")
+}
+
+// serverCandidates describes each Server on its own line, to tell apart Servers matching the same lookup.
+func serverCandidates(servers []*cbclient.CloudBoltServer) string {
+	lines := make([]string, 0, len(servers))
+	for _, svr := range servers {
+		lines = append(lines, fmt.Sprintf("  - %s %s (%s), ip %s, mac %s, status %s", svr.ID, svr.Hostname, svr.Links.Self.Href, svr.IP, svr.Mac, svr.Status))
+	}
+
+	return strings.Join(lines, "\n")
+}
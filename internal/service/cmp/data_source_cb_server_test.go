@@ -0,0 +1,99 @@
+package cmp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+)
+
+func TestServerLookupMatches(t *testing.T) {
+	svr := &cbclient.CloudBoltServer{
+		IP:  "10.0.0.5",
+		Mac: "00:50:56:aa:bb:cc",
+		Networks: []map[string]interface{}{
+			{"name": "NIC 1", "ip": "10.0.0.5", "mac": "00:50:56:aa:bb:cc"},
+			{"name": "NIC 2", "privateIp": "192.168.1.7", "mac": "00:50:56:dd:ee:ff"},
+		},
+		Attributes: []map[string]interface{}{
+			{"name": "service_tag", "value": "ABC123"},
+		},
+	}
+
+	tests := []struct {
+		ipAddress       string
+		mac             string
+		attributeFilter map[string]interface{}
+		want            bool
+	}{
+		{ipAddress: "10.0.0.5", want: true},
+		{ipAddress: "192.168.1.7", want: true},
+		{ipAddress: "10.0.0.6", want: false},
+		{mac: "00:50:56:DD:EE:FF", want: true},
+		{attributeFilter: map[string]interface{}{"service_tag": "ABC123"}, want: true},
+		{attributeFilter: map[string]interface{}{"service_tag": "XYZ"}, want: false},
+		{ipAddress: "10.0.0.5", attributeFilter: map[string]interface{}{"missing": "x"}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := serverLookupMatches(svr, tt.ipAddress, tt.mac, tt.attributeFilter); got != tt.want {
+			t.Errorf("serverLookupMatches(%q, %q, %v) = %v, want %v", tt.ipAddress, tt.mac, tt.attributeFilter, got, tt.want)
+		}
+	}
+
+	description := serverLookupDescription("10.0.0.5", "", map[string]interface{}{"service_tag": "ABC123"})
+	if description != `ip_address "10.0.0.5" and attribute service_tag = "ABC123"` {
+		t.Errorf("unexpected description %q", description)
+	}
+
+	svr.ID = "SVR-1"
+	svr.Hostname = "web01"
+	if candidates := serverCandidates([]*cbclient.CloudBoltServer{svr, svr}); strings.Count(candidates, "SVR-1 web01") != 2 {
+		t.Errorf("expected both candidates to be listed, got %q", candidates)
+	}
+}
+
+func TestFindServer(t *testing.T) {
+	const primary = `{"id": "SVR-1", "hostname": "web01", "ip": "10.0.0.5", "status": "ACTIVE", "networks": [{"ip": "10.0.0.5"}, {"ip": "192.168.1.7"}], "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-1/"}}}`
+
+	requests := make([]testRequest, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := recordRequest(t, r)
+		requests = append(requests, request)
+
+		switch {
+		case request.Path == "/api/v3/cmp/servers/SVR-1/":
+			fmt.Fprint(w, primary)
+		case request.Path == "/api/v3/cmp/servers/SVR-2/":
+			fmt.Fprint(w, `{"id": "SVR-2", "hostname": "db01", "ip": "10.0.0.9", "status": "ACTIVE", "networks": [{"ip": "10.0.0.9"}], "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-2/"}}}`)
+		case strings.Contains(request.Query.Get("filter"), "ip:10.0.0.5"):
+			fmt.Fprintf(w, `{"total": 1, "_embedded": {"servers": [%s]}}`, primary)
+		case strings.Contains(request.Query.Get("filter"), "ip:"):
+			fmt.Fprint(w, `{"total": 0, "_embedded": {"servers": []}}`)
+		default:
+			fmt.Fprint(w, `{"total": 2, "_embedded": {"servers": [{"id": "SVR-1", "status": "ACTIVE", "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-1/"}}}, {"id": "SVR-2", "status": "ACTIVE", "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-2/"}}}]}}`)
+		}
+	})
+
+	svr, err := findServer(client, "10.0.0.5", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if svr.ID != "SVR-1" || len(requests) != 2 || requests[0].Query.Get("filter") != "status.ne:HISTORICAL;ip:10.0.0.5" {
+		t.Errorf("expected SVR-1 from the primary NIC filter and one detail request, got %s from %+v", svr.ID, requests)
+	}
+
+	// A secondary NIC is not in the list summaries, every Server is fetched and matched on its details.
+	requests = requests[:0]
+	svr, err = findServer(client, "192.168.1.7", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if svr.ID != "SVR-1" || len(requests) != 4 || requests[1].Query.Get("filter") != "status.ne:HISTORICAL" || requests[2].Path != "/api/v3/cmp/servers/SVR-1/" || requests[3].Path != "/api/v3/cmp/servers/SVR-2/" {
+		t.Errorf("expected SVR-1 from the details of every Server, got %s from %+v", svr.ID, requests)
+	}
+}
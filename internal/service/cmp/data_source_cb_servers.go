@@ -0,0 +1,247 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceCloudBoltServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltServersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers in this CloudBolt Group, as a relative API URL path or global id",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers in this CloudBolt Environment, as a relative API URL path or global id",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers with this CloudBolt Status, e.g. \"ACTIVE\"",
+			},
+			"power_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers with this Power Status, e.g. \"POWERON\"",
+			},
+			"os_family": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers with this OS Family",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Servers with this Label",
+			},
+			"hostname_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only Servers with a hostname matching this regular expression",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The global ids of the matching CloudBolt Servers",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching CloudBolt Servers, sorted by hostname",
+				Elem: &schema.Resource{
+					Schema: serverItemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudBoltServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	var hostnameRegex *regexp.Regexp
+	if v := d.Get("hostname_regex").(string); v != "" {
+		hostnameRegex = regexp.MustCompile(v)
+	}
+
+	query := url.Values{}
+	if filter := serversFilter(d); filter != "" {
+		query.Set("filter", filter)
+	}
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "servers"), query, "servers")
+	if err != nil {
+		return diag.Errorf("Error listing Servers: %s", err)
+	}
+
+	ids := make([]string, 0)
+	servers := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		// Each list entry is decoded as a full Server, like cbclient.GetServerByHostname does, so the
+		// matching Servers are not fetched again one by one.
+		svr := &cbclient.CloudBoltServer{}
+		if err := json.Unmarshal(object, svr); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !hostnameMatches(hostnameRegex, svr.Hostname) || !serverMatches(svr, d) {
+			continue
+		}
+
+		server, err := parseServerItem(svr)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		servers = append(servers, server)
+	}
+
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i]["hostname"].(string) < servers[j]["hostname"].(string)
+	})
+
+	for _, server := range servers {
+		ids = append(ids, server["id"].(string))
+	}
+
+	d.SetId(listDataSourceID(ids))
+	d.Set("ids", ids)
+	d.Set("servers", servers)
+
+	return nil
+}
+
+// serverItemSchema is the schema of a Server as returned by parseServerItem.
+func serverItemSchema() map[string]*schema.Schema {
+	server := serverSchema()
+	server["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The global id of the CloudBolt Server",
+	}
+	server["url_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The relative API URL path for the CloudBolt Server",
+	}
+	server["group"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The relative API URL path for the CloudBolt Group of the Server",
+	}
+	server["environment"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The relative API URL path for the CloudBolt Environment of the Server",
+	}
+
+	return server
+}
+
+// parseServerItem extends parseServer with the identity and placement of the Server.
+func parseServerItem(svr *cbclient.CloudBoltServer) (map[string]interface{}, error) {
+	server, err := parseServer(svr)
+	if err != nil {
+		return nil, err
+	}
+
+	server["id"] = svr.ID
+	server["url_path"] = svr.Links.Self.Href
+	server["group"] = svr.Links.Group.Href
+	server["environment"] = svr.Links.Environment.Href
+
+	return server, nil
+}
+
+// serversFilter builds the API filter for the data source arguments, so only the matching Servers are listed.
+// Text arguments use iexact lookups, so the API matches them case-insensitively like serverMatches.
+func serversFilter(d *schema.ResourceData) string {
+	conds := make([]string, 0)
+
+	if group := d.Get("group").(string); group != "" {
+		conds = append(conds, "group.id:"+hrefId(group))
+	}
+
+	if env := d.Get("environment").(string); env != "" {
+		conds = append(conds, "environment.id:"+hrefId(env))
+	}
+
+	for _, k := range []string{"status", "power_status", "os_family"} {
+		if v := d.Get(k).(string); v != "" {
+			conds = append(conds, k+".iexact:"+v)
+		}
+	}
+
+	if label := d.Get("label").(string); label != "" {
+		conds = append(conds, "labels.name.iexact:"+label)
+	}
+
+	return conns.Filter(conds...)
+}
+
+// hostnameMatches reports whether a hostname matches the hostname_regex, every hostname matches when it is unset.
+func hostnameMatches(hostnameRegex *regexp.Regexp, hostname string) bool {
+	return hostnameRegex == nil || hostnameRegex.MatchString(hostname)
+}
+
+// serverMatches applies the data source filters to a Server.
+func serverMatches(svr *cbclient.CloudBoltServer, d *schema.ResourceData) bool {
+	if group := d.Get("group").(string); group != "" && !equivalentHref(svr.Links.Group.Href, group) {
+		return false
+	}
+
+	if env := d.Get("environment").(string); env != "" && !equivalentHref(svr.Links.Environment.Href, env) {
+		return false
+	}
+
+	if status := d.Get("status").(string); status != "" && !strings.EqualFold(svr.Status, status) {
+		return false
+	}
+
+	if powerStatus := d.Get("power_status").(string); powerStatus != "" && !strings.EqualFold(svr.PowerStatus, powerStatus) {
+		return false
+	}
+
+	if osFamily := d.Get("os_family").(string); osFamily != "" && !strings.EqualFold(svr.OsFamily, osFamily) {
+		return false
+	}
+
+	if label := d.Get("label").(string); label != "" {
+		found := false
+		for _, l := range svr.Labels {
+			name := convertValueToString(l)
+			if labelMap, ok := l.(map[string]interface{}); ok {
+				name = convertValueToString(labelMap["name"])
+			}
+
+			if strings.EqualFold(name, label) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestServersFilter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltServers().Schema, map[string]interface{}{
+		"group":        "/api/v3/cmp/groups/GRP-abcd1234/",
+		"environment":  "ENV-abcd1234",
+		"status":       "ACTIVE",
+		"power_status": "POWERON",
+		"os_family":    "Linux",
+		"label":        "web",
+	})
+
+	want := "group.id:GRP-abcd1234;environment.id:ENV-abcd1234;status.iexact:ACTIVE;power_status.iexact:POWERON;os_family.iexact:Linux;labels.name.iexact:web"
+	if got := serversFilter(d); got != want {
+		t.Errorf("serversFilter() = %q, want %q", got, want)
+	}
+
+	empty := schema.TestResourceDataRaw(t, DataSourceCloudBoltServers().Schema, map[string]interface{}{})
+	if got := serversFilter(empty); got != "" {
+		t.Errorf("expected no filter without arguments, got %q", got)
+	}
+}
+
+func TestHostnameMatches(t *testing.T) {
+	if !hostnameMatches(nil, "web01") {
+		t.Error("expected every hostname to match without a hostname_regex")
+	}
+
+	hostnameRegex := regexp.MustCompile("^web[0-9]+$")
+	if !hostnameMatches(hostnameRegex, "web01") {
+		t.Error("expected web01 to match")
+	}
+	if hostnameMatches(hostnameRegex, "db01") {
+		t.Error("expected db01 not to match")
+	}
+}
+
+func TestServerMatches(t *testing.T) {
+	svr := &cbclient.CloudBoltServer{
+		Status:      "ACTIVE",
+		PowerStatus: "POWERON",
+		OsFamily:    "Linux",
+		Labels: []interface{}{
+			map[string]interface{}{"name": "web"},
+			"frontend",
+		},
+	}
+	svr.Links.Group.Href = "/api/v3/cmp/groups/GRP-abcd1234/"
+	svr.Links.Environment.Href = "/api/v3/cmp/environments/ENV-abcd1234/"
+
+	tests := []struct {
+		raw  map[string]interface{}
+		want bool
+	}{
+		{raw: map[string]interface{}{}, want: true},
+		{raw: map[string]interface{}{"group": "GRP-abcd1234", "environment": "/api/v3/cmp/environments/ENV-abcd1234/"}, want: true},
+		{raw: map[string]interface{}{"group": "GRP-other"}, want: false},
+		{raw: map[string]interface{}{"status": "active", "power_status": "poweron", "os_family": "linux"}, want: true},
+		{raw: map[string]interface{}{"power_status": "POWEROFF"}, want: false},
+		{raw: map[string]interface{}{"label": "web"}, want: true},
+		{raw: map[string]interface{}{"label": "Frontend"}, want: true},
+		{raw: map[string]interface{}{"label": "db"}, want: false},
+	}
+
+	for _, tt := range tests {
+		d := schema.TestResourceDataRaw(t, DataSourceCloudBoltServers().Schema, tt.raw)
+		if got := serverMatches(svr, d); got != tt.want {
+			t.Errorf("serverMatches(%v) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestDataSourceCloudBoltServersRead(t *testing.T) {
+	requests := make([]testRequest, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, recordRequest(t, r))
+		fmt.Fprint(w, `{"total": 2, "_embedded": {"servers": [
+			{"id": "SVR-web00002", "hostname": "web02", "ipAddress": "10.0.0.6", "status": "ACTIVE", "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-web00002/"}}},
+			{"id": "SVR-db000001", "hostname": "db01", "ipAddress": "10.0.0.7", "status": "ACTIVE", "_links": {"self": {"href": "/api/v3/cmp/servers/SVR-db000001/"}}}
+		]}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltServers().Schema, map[string]interface{}{
+		"status":         "active",
+		"hostname_regex": "^web",
+	})
+	if diags := dataSourceCloudBoltServersRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(requests) != 1 || requests[0].Path != "/api/v3/cmp/servers/" || requests[0].Query.Get("filter") != "status.iexact:active" {
+		t.Errorf("expected the Servers to be listed once without fetching each one, got %+v", requests)
+	}
+
+	servers := d.Get("servers").([]interface{})
+	if len(servers) != 1 {
+		t.Fatalf("expected 1 server, got %v", servers)
+	}
+	if server := servers[0].(map[string]interface{}); server["id"] != "SVR-web00002" || server["ip_address"] != "10.0.0.6" || server["url_path"] != "/api/v3/cmp/servers/SVR-web00002/" {
+		t.Errorf("unexpected server %v", server)
+	}
+}
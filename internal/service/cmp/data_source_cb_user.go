@@ -0,0 +1,194 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceCloudBoltUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudBoltUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The global id of a CloudBolt User, required if no other lookup argument is provided",
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The relative API URL path for the CloudBolt User, required if no other lookup argument is provided",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username of the CloudBolt User, required if no other lookup argument is provided",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The email address of the CloudBolt User, case-insensitive, required if no other lookup argument is provided",
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first name of the CloudBolt User",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last name of the CloudBolt User",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the CloudBolt User can log in",
+			},
+			"is_super_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the CloudBolt User is a Super Admin",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Groups the User is a member of",
+				Elem:        referenceResource("Group"),
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Roles granted to the User",
+				Elem:        referenceResource("Role"),
+			},
+		},
+	}
+}
+
+// cloudBoltUser is a User as returned by the API.
+type cloudBoltUser struct {
+	Links struct {
+		Self   cbclient.CloudBoltHALItem   `json:"self"`
+		Groups []cbclient.CloudBoltHALItem `json:"groups"`
+		Roles  []cbclient.CloudBoltHALItem `json:"roles"`
+	} `json:"_links"`
+	ID           string `json:"id"`
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	IsActive     bool   `json:"isActive"`
+	IsSuperAdmin bool   `json:"isSuperAdmin"`
+}
+
+func dataSourceCloudBoltUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+	id := d.Get("id").(string)
+	urlPath := d.Get("url_path").(string)
+	username := d.Get("username").(string)
+	email := d.Get("email").(string)
+
+	if id == "" && urlPath == "" && username == "" && email == "" {
+		return diag.Errorf("Either id, url_path, username, or email is required")
+	}
+
+	if urlPath == "" && id != "" {
+		urlPath = conns.APIEndpoint("cloudbolt", "users", id)
+	}
+
+	var user *cloudBoltUser
+	var err error
+	if urlPath != "" {
+		user = &cloudBoltUser{}
+		if err = apiClient.Get(urlPath, nil, user); err != nil {
+			return diag.Errorf("Error getting User (%s): %s", urlPath, err)
+		}
+	} else if username != "" {
+		user, err = findUser(apiClient, "username", username)
+	} else {
+		user, err = findUser(apiClient, "email", email)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups := make([]map[string]interface{}, 0, len(user.Links.Groups))
+	for _, group := range user.Links.Groups {
+		groups = append(groups, halReference(group))
+	}
+
+	roles := make([]map[string]interface{}, 0, len(user.Links.Roles))
+	for _, role := range user.Links.Roles {
+		roles = append(roles, halReference(role))
+	}
+
+	d.SetId(user.ID)
+	d.Set("url_path", user.Links.Self.Href)
+	d.Set("username", user.Username)
+	d.Set("email", user.Email)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("is_active", user.IsActive)
+	d.Set("is_super_admin", user.IsSuperAdmin)
+	d.Set("groups", groups)
+	d.Set("roles", roles)
+
+	return nil
+}
+
+// findUser finds the single User whose username or email equals value.
+func findUser(apiClient *conns.CloudBoltClient, field string, value string) (*cloudBoltUser, error) {
+	query := url.Values{}
+	query.Set("filter", userLookupFilter(field, value))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cloudbolt", "users"), query, "users")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Users with %s %q: %s", field, value, err)
+	}
+
+	matches := make([]cloudBoltUser, 0, len(objects))
+	for _, object := range objects {
+		var user cloudBoltUser
+		if err := json.Unmarshal(object, &user); err != nil {
+			return nil, err
+		}
+
+		// Email addresses are matched case-insensitively, usernames exactly.
+		if (field == "email" && strings.EqualFold(user.Email, value)) || (field == "username" && user.Username == value) {
+			matches = append(matches, user)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find User with %s %q. Does the user have permission to view this?", field, value)
+	case 1:
+		return &matches[0], nil
+	}
+
+	usernames := make([]string, 0, len(matches))
+	for _, user := range matches {
+		usernames = append(usernames, user.Username)
+	}
+
+	return nil, fmt.Errorf("More than one User with %s %q found: %s. Use \"username\" or \"id\" to choose one.", field, value, strings.Join(usernames, ", "))
+}
+
+// userLookupFilter builds the API filter for a username or email, email addresses are compared case-insensitively.
+func userLookupFilter(field string, value string) string {
+	if field == "email" {
+		return conns.Filter("email.iexact:" + value)
+	}
+
+	return conns.Filter(field + ":" + value)
+}
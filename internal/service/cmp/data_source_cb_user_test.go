@@ -0,0 +1,40 @@
+package cmp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFindUser(t *testing.T) {
+	var request testRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request = recordRequest(t, r)
+		fmt.Fprint(w, `{"total": 2, "_embedded": {"users": [
+			{"id": "USR-1", "username": "alice", "email": "Alice@Example.com"},
+			{"id": "USR-2", "username": "alice2", "email": "alice@example.org"}
+		]}}`)
+	})
+
+	user, err := findUser(client, "email", "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if request.Query.Get("filter") != "email.iexact:alice@example.com" {
+		t.Errorf("unexpected email filter %q", request.Query.Get("filter"))
+	}
+
+	if user.ID != "USR-1" {
+		t.Errorf("expected USR-1 matching the email case-insensitively, got %s", user.ID)
+	}
+
+	if _, err := findUser(client, "username", "ALICE"); err == nil || !strings.Contains(err.Error(), "Could not find User") {
+		t.Errorf("expected usernames to match exactly, got %v", err)
+	}
+
+	if request.Query.Get("filter") != "username:ALICE" {
+		t.Errorf("unexpected username filter %q", request.Query.Get("filter"))
+	}
+}
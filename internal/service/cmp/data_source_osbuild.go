@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +29,103 @@ func DataSourceCloudBoltOSBuild() *schema.Resource {
 				Computed:    true,
 				Description: "The relative API URL path for the CloudBolt OS Build.",
 			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the CloudBolt OS Build",
+			},
+			"os_family": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OS Family of the OS Build, e.g. \"Amazon Linux\"",
+			},
+			"os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OS version of the OS Build, empty if CloudBolt does not report one",
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Environments that support the OS Build",
+				Elem:        referenceResource("Environment"),
+			},
+			"environment_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The global ids of the Environments that support the OS Build",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The templates, AMIs or images the OS Build maps to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The global id of the CloudBolt OS Image",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the CloudBolt OS Image",
+						},
+						"url_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt OS Image",
+						},
+						"template": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The template name, AMI id or image id in the cloud, empty if CloudBolt does not report one",
+						},
+						"resource_handler": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relative API URL path for the CloudBolt Resource Handler of the image",
+						},
+						"environments": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The relative API URL paths for the CloudBolt Environments the image is used in",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// cloudBoltOSBuildDetails holds the OS Build fields cbclient.CloudBoltReferenceFields does not.
+type cloudBoltOSBuildDetails struct {
+	Links struct {
+		Environments []cbclient.CloudBoltHALItem `json:"environments"`
+		Images       []cbclient.CloudBoltHALItem `json:"images"`
+	} `json:"_links"`
+	Description string `json:"description"`
+	OsFamily    string `json:"osFamily"`
+	OsVersion   string `json:"osVersion"`
+}
+
+// cloudBoltOSImage is an image of an OS Build.
+type cloudBoltOSImage struct {
+	Links struct {
+		ResourceHandler cbclient.CloudBoltHALItem   `json:"resourceHandler"`
+		Environments    []cbclient.CloudBoltHALItem `json:"environments"`
+	} `json:"_links"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	TemplateName string `json:"templateName"`
+	AmiID        string `json:"amiId"`
+	ImageID      string `json:"imageId"`
+}
+
 func dataSourceCloudBoltOSBuildRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 	id := d.Get("id").(string)
 
@@ -60,5 +152,55 @@ func dataSourceCloudBoltOSBuildRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("name", osbuild.Name)
 	}
 
+	var details cloudBoltOSBuildDetails
+	if err := apiClient.Get(osbuild.Links.Self.Href, nil, &details); err != nil {
+		return diag.Errorf("Error getting OS Build (%s) details: %s", osbuild.ID, err)
+	}
+
+	environments := make([]map[string]interface{}, 0, len(details.Links.Environments))
+	environmentIds := make([]string, 0, len(details.Links.Environments))
+	for _, env := range details.Links.Environments {
+		environment := halReference(env)
+		environments = append(environments, environment)
+		environmentIds = append(environmentIds, environment["id"].(string))
+	}
+
+	images := make([]map[string]interface{}, 0, len(details.Links.Images))
+	for _, img := range details.Links.Images {
+		var image cloudBoltOSImage
+		if err := apiClient.Get(img.Href, nil, &image); err != nil {
+			return diag.Errorf("Error getting OS Image (%s): %s", img.Href, err)
+		}
+
+		imageEnvironments := make([]string, 0, len(image.Links.Environments))
+		for _, env := range image.Links.Environments {
+			imageEnvironments = append(imageEnvironments, env.Href)
+		}
+
+		template := image.TemplateName
+		if template == "" {
+			template = image.AmiID
+		}
+		if template == "" {
+			template = image.ImageID
+		}
+
+		images = append(images, map[string]interface{}{
+			"id":               image.ID,
+			"name":             image.Name,
+			"url_path":         img.Href,
+			"template":         template,
+			"resource_handler": image.Links.ResourceHandler.Href,
+			"environments":     imageEnvironments,
+		})
+	}
+
+	d.Set("description", details.Description)
+	d.Set("os_family", details.OsFamily)
+	d.Set("os_version", details.OsVersion)
+	d.Set("environments", environments)
+	d.Set("environment_ids", environmentIds)
+	d.Set("images", images)
+
 	return nil
 }
@@ -0,0 +1,90 @@
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceCloudBoltOSBuildRead(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/cmp/osBuilds/OSB-abcd1234/":
+			fmt.Fprint(w, `{
+				"id": "OSB-abcd1234",
+				"name": "Ubuntu 22.04",
+				"description": "Ubuntu LTS",
+				"osFamily": "Ubuntu",
+				"osVersion": "22.04",
+				"_links": {
+					"self": {"href": "/api/v3/cmp/osBuilds/OSB-abcd1234/"},
+					"environments": [{"href": "/api/v3/cmp/environments/ENV-abcd1234/", "title": "AWS us-east-1"}],
+					"images": [
+						{"href": "/api/v3/cmp/osBuildImages/IMG-aws00001/"},
+						{"href": "/api/v3/cmp/osBuildImages/IMG-vmw00001/"}
+					]
+				}
+			}`)
+		case "/api/v3/cmp/osBuildImages/IMG-aws00001/":
+			fmt.Fprint(w, `{
+				"id": "IMG-aws00001",
+				"name": "ubuntu-2204-us-east-1",
+				"amiId": "ami-0abcd1234",
+				"_links": {
+					"resourceHandler": {"href": "/api/v3/cmp/resourceHandlers/RH-aws00001/"},
+					"environments": [{"href": "/api/v3/cmp/environments/ENV-abcd1234/"}]
+				}
+			}`)
+		case "/api/v3/cmp/osBuildImages/IMG-vmw00001/":
+			fmt.Fprint(w, `{
+				"id": "IMG-vmw00001",
+				"name": "ubuntu-2204-template",
+				"templateName": "ubuntu-2204",
+				"imageId": "vm-1234",
+				"_links": {"resourceHandler": {"href": "/api/v3/cmp/resourceHandlers/RH-vmw00001/"}}
+			}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudBoltOSBuild().Schema, map[string]interface{}{"id": "OSB-abcd1234"})
+	if diags := dataSourceCloudBoltOSBuildRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if d.Get("name") != "Ubuntu 22.04" || d.Get("os_family") != "Ubuntu" || d.Get("os_version") != "22.04" {
+		t.Errorf("unexpected OS Build %v %v %v", d.Get("name"), d.Get("os_family"), d.Get("os_version"))
+	}
+
+	environments := d.Get("environments").([]interface{})
+	if len(environments) != 1 {
+		t.Fatalf("expected 1 environment, got %v", environments)
+	}
+	if environment := environments[0].(map[string]interface{}); environment["id"] != "ENV-abcd1234" || environment["name"] != "AWS us-east-1" {
+		t.Errorf("unexpected environment %v", environment)
+	}
+
+	if environmentIds := d.Get("environment_ids").([]interface{}); len(environmentIds) != 1 || environmentIds[0] != "ENV-abcd1234" {
+		t.Errorf("unexpected environment ids %v", environmentIds)
+	}
+
+	images := d.Get("images").([]interface{})
+	if len(images) != 2 {
+		t.Fatalf("expected 2 images, got %v", images)
+	}
+
+	aws := images[0].(map[string]interface{})
+	if aws["template"] != "ami-0abcd1234" || aws["resource_handler"] != "/api/v3/cmp/resourceHandlers/RH-aws00001/" || len(aws["environments"].([]interface{})) != 1 {
+		t.Errorf("expected the AMI id as the template, got %v", aws)
+	}
+
+	vmware := images[1].(map[string]interface{})
+	if vmware["template"] != "ubuntu-2204" || vmware["url_path"] != "/api/v3/cmp/osBuildImages/IMG-vmw00001/" {
+		t.Errorf("expected the template name to be preferred over the image id, got %v", vmware)
+	}
+}
@@ -3,6 +3,7 @@ package cmp
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,26 +11,67 @@ import (
 	"strings"
 	"time"
 	"log"
+	"net"
+	"path"
 	"runtime/debug"
+	"sort"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	expirationDateParameter = "expiration_date"
+	setExpirationDateAction = "Set Expiration Date"
+	groupParameter          = "group"
+	changeGroupAction       = "Change Group"
+	ownerParameter          = "owner"
+	changeOwnerAction       = "Change Owner"
+)
+
+// dateLayouts are the formats accepted for date arguments, e.g. "expiration_date", and returned by CloudBolt.
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.999999",
+	time.RFC3339,
+}
+
 func ResourceBPInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBPInstanceCreate,
 		ReadContext:   resourceBPInstanceRead,
 		UpdateContext: resourceBPInstanceUpdate,
 		DeleteContext: resourceBPInstanceDelete,
+		CustomizeDiff: resourceBPInstanceCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceBPInstanceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceBPInstanceStateUpgradeV0,
+				Version: 0,
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The relative API URL path for the CloudBolt Group",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentHref,
+				Description:      "The relative API URL path for the CloudBolt Group, changing it moves the Resource to the new Group",
+			},
+			"owner": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentHref,
+				Description:      "The relative API URL path for the CloudBolt User that owns the Resource or Servers",
 			},
 			"blueprint_id": {
 				Type:        schema.TypeString,
@@ -41,17 +83,64 @@ func ResourceBPInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Parameters Name/Value pair",
 			},
+			"sensitive_parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Parameters Name/Value pair that are never shown in plans or read back from CloudBolt, merged over \"parameters\"",
+			},
 			"resource_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The name for the created CloudBolt Resoucce",
 			},
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateDate,
+				DiffSuppressFunc: suppressEquivalentExpirationDate,
+				Description:      "The date the CloudBolt Resource or Servers expire, e.g. \"2026-12-31\" or \"2026-12-31T17:00:00\"",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     30,
 				Description: "Timeout in minutes, Default (30)",
 			},
+			"wait_for": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Conditions every deployed Server must meet before create completes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"power_status": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The Server power status to wait for, e.g. \"POWERON\"",
+						},
+						"ip_assigned": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Wait until the Server has an IP address",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Wait until this TCP port accepts connections on the Server IP address",
+						},
+						"timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     10,
+							Description: "Timeout in minutes, Default (10)",
+						},
+					},
+				},
+			},
 			"deployment_item": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -84,145 +173,298 @@ func ResourceBPInstance() *schema.Resource {
 			"servers": {
 				Type:     schema.TypeList,
 				Computed: true,
+				Elem: &schema.Resource{
+					Schema: serverSchema(),
+				},
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of deployed instance Resource or Server",
+			},
+			"resource_href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the deployed CloudBolt Resource",
+			},
+			"server_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The global ids of the deployed CloudBolt Servers",
+			},
+			"attributes": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "CloudBolt Resource attributes",
+			},
+			"attributes_json": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "CloudBolt Resource attributes with each value encoded as JSON",
+			},
+			"post_create_action": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        actionHookResource(),
+				Description: "Ordered list of resource actions to run after the order succeeds",
+			},
+			"pre_destroy_action": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        actionHookResource(),
+				Description: "Ordered list of resource actions to run before the Resource is deleted",
+			},
+			"action_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Results of the post_create_action hooks, pre_destroy_action results are only logged because the state is removed on delete",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"hostname": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Server Hostname",
-						},
-						"ip_address": {
+						"name": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Server IP Address",
+							Description: "The resource action name",
 						},
 						"status": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "CloudBolt Server Status",
-						},
-						"mac": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Server MAC Address",
-						},
-						"power_status": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Server Power Status",
-						},
-						"date_added_to_cloudbolt": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Date the server was added to CloudBolt",
-						},
-						"cpu_count": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "CPU Count",
-						},
-						"memory_size_gb": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Total Memory in GB",
+							Description: "The resource action status, SUCCESS or FAILURE",
 						},
-						"disk_size_gb": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Total Disk Size in GB",
-						},
-						"notes": {
+						"output": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Server Notes",
-						},
-						"labels": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: "Server Labels",
+							Description: "The resource action output",
 						},
-						"os_family": {
+						"error": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Server OS Family",
-						},
-						"attributes": {
-							Type:     schema.TypeMap,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: "CloudBolt Server attributes",
-						},
-						"rate_breakdown": {
-							Type:     schema.TypeMap,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: "Server Rate Breakdown",
-						},
-						"tech_specific_attributes": {
-							Type:     schema.TypeMap,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Description: "Resource Handler technical specific attributes",
-						},
-						"disks": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"uuid": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "Unique ID of Disk",
-									},
-									"name": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "Name of Disk",
-									},
-									"disk_size_gb": {
-										Type:        schema.TypeInt,
-										Computed:    true,
-										Description: "Disk Size in GB",
-									},
-								},
-							},
-							Description: "Server disks",
-						},
-						"networks": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeMap,
-								Elem: &schema.Schema{
-									Type: schema.TypeString,
-								},
-							},
-							Description: "Server NICs",
+							Description: "The resource action errors",
 						},
 					},
 				},
 			},
-			"instance_type": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The type of deployed instance Resource or Server",
+		},
+	}
+}
+
+// serverSchema is the schema of a Server as returned by parseServer.
+func serverSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"hostname": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server Hostname",
+		},
+		"ip_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server IP Address",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CloudBolt Server Status",
+		},
+		"mac": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server MAC Address",
+		},
+		"power_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server Power Status",
+		},
+		"date_added_to_cloudbolt": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date the server was added to CloudBolt",
+		},
+		"cpu_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU Count",
+		},
+		"memory_size_gb": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Total Memory in GB",
+		},
+		"disk_size_gb": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total Disk Size in GB",
+		},
+		"notes": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server Notes",
+		},
+		"labels": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"attributes": {
+			Description: "Server Labels",
+		},
+		"os_family": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server OS Family",
+		},
+		"attributes": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "CloudBolt Server attributes",
+		},
+		"attributes_json": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "CloudBolt Server attributes with each value encoded as JSON",
+		},
+		"rate_breakdown": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Server Rate Breakdown",
+		},
+		"tech_specific_attributes": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Resource Handler technical specific attributes",
+		},
+		"disks": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Unique ID of Disk",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of Disk",
+					},
+					"disk_size_gb": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Disk Size in GB",
+					},
+					"datastore": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Datastore or storage account the Disk is placed on",
+					},
+					"provisioning_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Disk provisioning type, e.g. thin or thick",
+					},
+				},
+			},
+			Description: "Server disks",
+		},
+		"nics": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of NIC",
+					},
+					"network": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Network the NIC is attached to",
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "NIC IP Address",
+					},
+					"mac": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "NIC MAC Address",
+					},
+					"private_ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "NIC Private IP Address",
+					},
+					"public_ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "NIC Public IP Address",
+					},
+					"primary": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether this is the primary NIC of the Server",
+					},
+				},
+			},
+			Description: "Server NICs",
+		},
+		"networks": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Computed:    true,
-				Description: "CloudBolt Resource attributes",
+			},
+			Description: "Server NICs",
+		},
+	}
+}
+
+// actionHookResource is the schema of a post_create_action or pre_destroy_action block.
+func actionHookResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the CloudBolt resource action",
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Parameters Name/Value pair",
+			},
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Fail the apply when the action fails, Default (true)",
 			},
 		},
 	}
@@ -232,35 +474,19 @@ func resourceBPInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 	var diags diag.Diagnostics
 	defer withPanicRecovery(&diags, "Create")
 
-	apiClient := m.(*cbclient.CloudBoltClient)
-
-	bpItems := make([]map[string]interface{}, 0)
-	bpItemList := d.Get("deployment_item").(*schema.Set).List()
-	bpParams := normalizeParameters(d.Get("parameters").(map[string]interface{}))
-	for _, v := range bpItemList {
-		m := v.(map[string]interface{})
-		itemParams := normalizeParameters(m["parameters"].(map[string]interface{}))
-		bpItem := map[string]interface{}{
-			"bp-item-name":    m["name"].(string),
-			"bp-item-paramas": itemParams,
-		}
-
-		env, ok := m["environment"]
-		if ok && env != "" {
-			bpItem["environment"] = env.(string)
-		}
+	apiClient := m.(*conns.CloudBoltClient)
 
-		osb, ok := m["osbuild"]
-		if ok && osb != "" {
-			bpItem["osbuild"] = osb.(string)
-		}
+	sensitiveParams := getSensitiveParameters(d)
+	bpParams := mergeParameters(normalizeParameters(d.Get("parameters").(map[string]interface{})), normalizeParameters(sensitiveParams))
+	bpItems := expandDeploymentItems(d.Get("deployment_item").(*schema.Set).List())
 
-		bpItems = append(bpItems, bpItem)
+	if expirationDate, ok := d.GetOk("expiration_date"); ok {
+		bpParams[expirationDateParameter] = expirationDate.(string)
 	}
 
 	order, err := apiClient.DeployBlueprint(d.Get("group").(string), d.Get("blueprint_id").(string), d.Get("resource_name").(string), bpParams, bpItems)
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.Errorf("%s", redactSensitiveValues(err.Error(), sensitiveParams))
 	}
 
 	requestTimeout := d.Get("request_timeout").(int)
@@ -274,7 +500,7 @@ func resourceBPInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	_, err = stateChangeConf.WaitForState()
 	if err != nil {
-		return diag.Errorf("Error waiting for Order (%s) to complete. Error: %s", order.ID, err)
+		return diag.Errorf("Error waiting for Order (%s) to complete. Error: %s", order.ID, redactSensitiveValues(err.Error(), sensitiveParams))
 	}
 
 	// Retrieve the updated order to obtain Resource ID
@@ -294,14 +520,12 @@ func resourceBPInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 		if job.Type == "deploy_blueprint" {
 			if len(job.Links.Resource.Href) > 0 {
 				resourceId = job.Links.Resource.Href
-				d.Set("instance_type", "Resource")
 			} else if len(job.Links.Servers) > 0 {
 				for _, s := range job.Links.Servers {
 					serverHref := strings.TrimRight(s.Href, "/")
 					index := strings.LastIndex(serverHref, "/")
 					servers = append(servers, serverHref[index+1:])
 				}
-				d.Set("instance_type", "Server")
 			}
 			break
 		}
@@ -312,11 +536,41 @@ func resourceBPInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	if resourceId != "" {
-		d.SetId(resourceId)
+		d.SetId(bpInstanceResourceID(resourceId))
 	} else {
-		d.SetId(strings.Join(servers, "_"))
+		d.SetId(bpInstanceServersID(servers))
+	}
+
+	if owner, ok := d.GetOk("owner"); ok {
+		if resourceId == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The owner is only changed for CloudBolt Resources.",
+				Detail:   fmt.Sprintf("Order (%s) deployed Servers, they keep the owner CloudBolt assigned instead of %s.", order.ID, owner.(string)),
+			})
+		} else {
+			// A failed transfer still records state, the error taints the instance so it is replaced on the next apply.
+			actionDiags := runNamedResourceAction(apiClient, resourceId, changeOwnerAction, map[string]interface{}{
+				ownerParameter: owner.(string),
+			}, requestTimeout, sensitiveParams)
+			if actionDiags.HasError() {
+				diags = append(diags, actionDiags...)
+				return append(diags, resourceBPInstanceRead(ctx, d, m)...)
+			}
+		}
+	}
+
+	// A Server that is not ready still records state, the error taints the instance so it is replaced on the next apply.
+	if waitDiags := waitForServers(apiClient, d); waitDiags.HasError() {
+		diags = append(diags, waitDiags...)
+		return append(diags, resourceBPInstanceRead(ctx, d, m)...)
 	}
 
+	// A failed hook still records state, the error taints the instance so it is replaced on the next apply.
+	actionResults, hookDiags := runActionHooks(apiClient, d, "post_create_action", requestTimeout, sensitiveParams)
+	d.Set("action_results", actionResults)
+	diags = append(diags, hookDiags...)
+
 	// Populate Terraform state by reading the resource
 	readDiags := resourceBPInstanceRead(ctx, d, m)
 	diags = append(diags, readDiags...)
@@ -324,6 +578,33 @@ func resourceBPInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 	return diags
 }
 
+// expandDeploymentItems converts "deployment_item" blocks to the items accepted by DeployBlueprint.
+func expandDeploymentItems(bpItemList []interface{}) []map[string]interface{} {
+	bpItems := make([]map[string]interface{}, 0)
+	for _, v := range bpItemList {
+		m := v.(map[string]interface{})
+		itemParams := normalizeParameters(m["parameters"].(map[string]interface{}))
+		bpItem := map[string]interface{}{
+			"bp-item-name":    m["name"].(string),
+			"bp-item-paramas": itemParams,
+		}
+
+		env, ok := m["environment"]
+		if ok && env != "" {
+			bpItem["environment"] = env.(string)
+		}
+
+		osb, ok := m["osbuild"]
+		if ok && osb != "" {
+			bpItem["osbuild"] = osb.(string)
+		}
+
+		bpItems = append(bpItems, bpItem)
+	}
+
+	return bpItems
+}
+
 func parseAttributes(attributes []map[string]interface{}) (map[string]interface{}, error) {
 	resAttributes := make(map[string]interface{}, 0)
 
@@ -335,6 +616,25 @@ func parseAttributes(attributes []map[string]interface{}) (map[string]interface{
 	return resAttributes, nil
 }
 
+// parseAttributesJSON keeps every attribute value as its JSON encoding so nested
+// objects, lists and numbers survive without going through convertValueToString.
+func parseAttributesJSON(attributes []map[string]interface{}) (map[string]interface{}, error) {
+	resAttributes := make(map[string]interface{}, 0)
+
+	for _, attr := range attributes {
+		attrName, _ := attr["name"].(string)
+
+		valueJSON, err := json.Marshal(attr["value"])
+		if err != nil {
+			return nil, fmt.Errorf("Unable to encode attribute %s as JSON: %s", attrName, err)
+		}
+
+		resAttributes[attrName] = string(valueJSON)
+	}
+
+	return resAttributes, nil
+}
+
 func convertValueToString(value interface{}) string {
 	var stringValue string
 
@@ -383,6 +683,40 @@ func convertValueToString(value interface{}) string {
 	return stringValue
 }
 
+// listDataSourceID derives the ID of a data source result that has no identity in CloudBolt, e.g. a list
+// of Servers or an order estimate, from the ids or request it is built from.
+func listDataSourceID(ids []string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ","))))
+}
+
+// convertValueToPlainString converts a value like convertValueToString, but numbers are never in exponent form,
+// e.g. 1000000 is "1000000" rather than "1e+06".
+func convertValueToPlainString(value interface{}) string {
+	if floatValue, ok := value.(float64); ok {
+		return strconv.FormatFloat(floatValue, 'f', -1, 64)
+	}
+
+	return convertValueToString(value)
+}
+
+// convertValueToInt converts a JSON number, or a string holding one, to an int.
+// Values that are not numeric convert to 0.
+func convertValueToInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case string:
+		floatValue, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err == nil {
+			return int(floatValue)
+		}
+	}
+
+	return 0
+}
+
 func convertValuesToString(attributes map[string]interface{}) map[string]interface{} {
 	stringValues := make(map[string]interface{}, 0)
 
@@ -500,25 +834,11 @@ func parseServer(svr *cbclient.CloudBoltServer) (map[string]interface{}, error)
 	}
 
 	if len(svr.Disks) > 0 {
-		disks := make([]map[string]interface{}, 0)
-
-		for _, d := range svr.Disks {
-			uuid, _ := d["uuid"]
-			name, _ := d["name"]
-			disk_size_gb, _ := d["diskSize"]
-
-			disk := map[string]interface{}{
-				"uuid":         uuid,
-				"name":         name,
-				"disk_size_gb": disk_size_gb,
-			}
-			disks = append(disks, disk)
-		}
-
-		server["disks"] = disks
+		server["disks"] = parseServerDisks(svr.Disks)
 	}
 
 	if len(svr.Networks) > 0 {
+		server["nics"] = parseServerNICs(svr.Networks)
 		server["networks"] = svr.Networks
 	}
 
@@ -529,24 +849,81 @@ func parseServer(svr *cbclient.CloudBoltServer) (map[string]interface{}, error)
 	svrAttributes, _ := parseAttributes(svr.Attributes)
 	server["attributes"] = svrAttributes
 
+	svrAttributesJSON, err := parseAttributesJSON(svr.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	server["attributes_json"] = svrAttributesJSON
+
 	return server, nil
 }
 
-func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	defer withPanicRecovery(&diags, "Read")
+func parseServerDisks(svrDisks []map[string]interface{}) []map[string]interface{} {
+	disks := make([]map[string]interface{}, 0, len(svrDisks))
 
-	apiClient := m.(*cbclient.CloudBoltClient)
-	instanceType := d.Get("instance_type").(string)
-	allAttributes := make(map[string]interface{})
+	for _, d := range svrDisks {
+		disk := map[string]interface{}{
+			"uuid":              convertValueToString(d["uuid"]),
+			"name":              convertValueToString(d["name"]),
+			"disk_size_gb":      convertValueToInt(d["diskSize"]),
+			"datastore":         convertValueToString(d["datastore"]),
+			"provisioning_type": convertValueToString(d["provisioningType"]),
+		}
+		disks = append(disks, disk)
+	}
 
-	if instanceType == "Resource" {
-		res, err := apiClient.GetResource(d.Id())
-		if err != nil {
-			if errors.Is(err, cbclient.ErrNotFound) {
-				d.SetId("")
-				return diags
-			}
+	return disks
+}
+
+func parseServerNICs(networks []map[string]interface{}) []map[string]interface{} {
+	nics := make([]map[string]interface{}, 0, len(networks))
+
+	for i, n := range networks {
+		// Not every Resource Handler reports the primary NIC, the first one is then assumed to be primary.
+		primary, ok := n["primary"].(bool)
+		if !ok {
+			primary = i == 0
+		}
+
+		nic := map[string]interface{}{
+			"name":       convertValueToString(n["name"]),
+			"network":    convertValueToString(n["network"]),
+			"ip":         convertValueToString(n["ip"]),
+			"mac":        convertValueToString(n["mac"]),
+			"private_ip": convertValueToString(n["privateIp"]),
+			"public_ip":  convertValueToString(n["publicIp"]),
+			"primary":    primary,
+		}
+		nics = append(nics, nic)
+	}
+
+	return nics
+}
+
+func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	defer withPanicRecovery(&diags, "Read")
+
+	apiClient := m.(*conns.CloudBoltClient)
+	allAttributes := make(map[string]interface{})
+	sensitiveParams := getSensitiveParameters(d)
+
+	instanceType, resourceHref, serverIds, err := parseBPInstanceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("instance_type", instanceType)
+	d.Set("resource_href", resourceHref)
+	d.Set("server_ids", serverIds)
+
+	if instanceType == bpInstanceTypeResource {
+		res, err := apiClient.GetResource(resourceHref)
+		if err != nil {
+			if errors.Is(err, cbclient.ErrNotFound) {
+				d.SetId("")
+				return diags
+			}
 
 			return diag.FromErr(err)
 		}
@@ -556,6 +933,8 @@ func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
+		setGroupAndOwner(d, res.Links.Group.Href, res.Links.Owner.Href)
+
 		var servers []map[string]interface{}
 		for _, s := range res.Links.Servers {
 			svr, svrerr := apiClient.GetServer(s.Href)
@@ -563,7 +942,13 @@ func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 				return diag.Errorf("Error getting Servers for Resource: %s", svrerr.Error())
 			}
 
-			server, _ := parseServer(svr)
+			server, err := parseServer(svr)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			removeSensitiveAttributes(server["attributes"].(map[string]interface{}), sensitiveParams)
+			removeSensitiveAttributes(server["attributes_json"].(map[string]interface{}), sensitiveParams)
 			servers = append(servers, server)
 
 			if len(res.Links.Servers) == 1 {
@@ -590,22 +975,42 @@ func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 		d.Set("servers", servers)
 
 		resAttributes, _ := parseAttributes(res.Attributes)
+		removeSensitiveAttributes(resAttributes, sensitiveParams)
 		for k, v := range resAttributes {
 			allAttributes[k] = v
 		}
 
+		// Keep the configured date when CloudBolt does not store it as an attribute.
+		if expirationDate, ok := resAttributes[expirationDateParameter].(string); ok {
+			d.Set("expiration_date", expirationDate)
+		}
+
 		d.Set("attributes", resAttributes)
-	} else {
-		serverIds := strings.Split(d.Id(), "_")
 
+		resAttributesJSON, err := parseAttributesJSON(res.Attributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		removeSensitiveAttributes(resAttributesJSON, sensitiveParams)
+		d.Set("attributes_json", resAttributesJSON)
+	} else {
 		servers := make([]map[string]interface{}, 0)
+		svrs := make([]*cbclient.CloudBoltServer, 0)
 		for _, serverId := range serverIds {
 			svr, svrerr := apiClient.GetServerById(serverId)
 			if svrerr != nil {
 				return diag.Errorf("Error getting Server: %s", svrerr.Error())
 			}
+			svrs = append(svrs, svr)
 
-			server, _ := parseServer(svr)
+			server, err := parseServer(svr)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			removeSensitiveAttributes(server["attributes"].(map[string]interface{}), sensitiveParams)
+			removeSensitiveAttributes(server["attributes_json"].(map[string]interface{}), sensitiveParams)
 			servers = append(servers, server)
 
 			if len(serverIds) == 1 {
@@ -633,6 +1038,18 @@ func resourceBPInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 		if servers != nil {
 			d.Set("servers", servers)
 		}
+
+		// Servers ordered together share the expiration date, group and owner, the first Server is representative.
+		if len(svrs) > 0 {
+			setGroupAndOwner(d, svrs[0].Links.Group.Href, svrs[0].Links.Owner.Href)
+		}
+
+		if len(servers) > 0 {
+			attributes, _ := servers[0]["attributes"].(map[string]interface{})
+			if expirationDate, ok := attributes[expirationDateParameter].(string); ok {
+				d.Set("expiration_date", expirationDate)
+			}
+		}
 	}
 
 	if parameters, ok := getParameters(d); ok {
@@ -652,8 +1069,13 @@ func resourceBPInstanceUpdate(ctx context.Context, d *schema.ResourceData, m int
 	var diags diag.Diagnostics
 	defer withPanicRecovery(&diags, "Update")
 
-	instanceType := d.Get("instance_type").(string)
-	if instanceType != "Resource" {
+	instanceType, resourceHref, _, err := parseBPInstanceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Servers have no resource actions, every change needs one.
+	if instanceType != bpInstanceTypeResource && d.HasChanges("parameters", "sensitive_parameters", "deployment_item", "expiration_date", "group", "owner") {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "The CloudBolt provider does not support Terraform config updates for Servers.",
@@ -662,9 +1084,10 @@ func resourceBPInstanceUpdate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	requestTimeout := d.Get("request_timeout").(int)
-	if d.HasChange("parameters") || d.HasChange("deployment_item") {
-		apiClient := m.(*cbclient.CloudBoltClient)
-		actionPath, geterr := getResourceActionPath(apiClient, d.Id(), "Terraform Provider Update", true)
+	sensitiveParams := getSensitiveParameters(d)
+	if d.HasChange("parameters") || d.HasChange("sensitive_parameters") || d.HasChange("deployment_item") {
+		apiClient := m.(*conns.CloudBoltClient)
+		actionPath, geterr := getResourceActionPath(apiClient, resourceHref, "Terraform Provider Update", true)
 		if geterr != nil {
 			return diag.FromErr(geterr)
 		}
@@ -679,7 +1102,7 @@ func resourceBPInstanceUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 		tfConfigParams := make(map[string]interface{}, 0)
 		bpItemList := d.Get("deployment_item").(*schema.Set).List()
-		bpParams := normalizeParameters(d.Get("parameters").(map[string]interface{}))
+		bpParams := mergeParameters(normalizeParameters(d.Get("parameters").(map[string]interface{})), normalizeParameters(sensitiveParams))
 
 		if bpParams != nil {
 			tfConfigParams["parameters"] = bpParams
@@ -693,121 +1116,185 @@ func resourceBPInstanceUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 		parametersJSON, jsonerr := json.Marshal(tfConfigParams)
 		if jsonerr != nil {
-			fmt.Println(jsonerr)
+			return diag.FromErr(jsonerr)
 		}
 
-		fmt.Println(string(parametersJSON))
+		log.Printf("[DEBUG] [provider.cloudbolt] Terraform Provider Update parameters: %s", redactSensitiveValues(string(parametersJSON), sensitiveParams))
 
 		parameters := map[string]interface{}{
 			"tf_config_parameters": string(parametersJSON),
 		}
 
-		runActionResult, upderr := apiClient.SubmitAction(actionPath, d.Id(), parameters)
-		if upderr != nil {
-			return diag.FromErr(upderr)
+		_, actionDiags := runResourceAction(apiClient, actionPath, resourceHref, parameters, requestTimeout, sensitiveParams)
+		if actionDiags.HasError() {
+			return actionDiags
 		}
+	}
 
-		if runActionResult.Results.Status != "" {
-			if runActionResult.Results.Status != "SUCCESS" {
-				var b strings.Builder
+	// The group and owner are changed last, a failed update leaves the instance where it was.
+	for _, change := range []struct{ key, action, parameter string }{
+		{"expiration_date", setExpirationDateAction, expirationDateParameter},
+		{"group", changeGroupAction, groupParameter},
+		{"owner", changeOwnerAction, ownerParameter},
+	} {
+		value := d.Get(change.key).(string)
+		if !d.HasChange(change.key) || value == "" {
+			continue
+		}
 
-				// First line (single line, status included)
-				fmt.Fprintf(
-					&b,
-					"Action failed (status=%s).\n\n",
-					runActionResult.Results.Status,
-				)
+		actionDiags := runNamedResourceAction(m.(*conns.CloudBoltClient), resourceHref, change.action, map[string]interface{}{
+			change.parameter: value,
+		}, requestTimeout, sensitiveParams)
+		if actionDiags.HasError() {
+			return actionDiags
+		}
+	}
 
-				// Errors (multiline)
-				if strings.TrimSpace(runActionResult.Results.ErrorMessage) != "" {
-					b.WriteString("Errors:\n")
-					for _, line := range strings.Split(
-						strings.TrimRight(runActionResult.Results.ErrorMessage, "\n"),
-						"\n",
-					) {
-						fmt.Fprintf(&b, "  • %s\n", line)
-					}
-					b.WriteString("\n")
-				}
+	// Populate Terraform state by reading the resource
+	readDiags := resourceBPInstanceRead(ctx, d, m)
+	diags = append(diags, readDiags...)
 
-				// Output (multiline)
-				if strings.TrimSpace(runActionResult.Results.OutputMessage) != "" {
-					b.WriteString("Output:\n")
-					for _, line := range strings.Split(
-						strings.TrimRight(runActionResult.Results.OutputMessage, "\n"),
-						"\n",
-					) {
-						fmt.Fprintf(&b, "  • %s\n", line)
-					}
-				}
+	return diags
+}
 
-				return diag.Errorf(b.String())
-			}
-		} else {
-			stateChangeConf := resource.StateChangeConf{
-				Delay:   10 * time.Second,
-				Timeout: time.Duration(requestTimeout) * time.Minute,
-				Pending: []string{"INIT", "QUEUED", "PENDING", "RUNNING", "TO_CANCEL"},
-				Target:  []string{"SUCCESS"},
-			}
+// runNamedResourceAction runs the CloudBolt resource action titled actionName, e.g. "Set Expiration Date",
+// and fails when the Resource does not have it.
+func runNamedResourceAction(apiClient *conns.CloudBoltClient, resourceHref string, actionName string, parameters map[string]interface{}, requestTimeout int, sensitiveParams map[string]interface{}) diag.Diagnostics {
+	actionPath, err := getResourceActionPath(apiClient, resourceHref, actionName, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			var runProcessType string
-			if runActionResult.Results.Job.Links.Self.Href != "" {
-				stateChangeConf.Pending = []string{"INIT", "QUEUED", "PENDING", "RUNNING", "TO_CANCEL"}
-				stateChangeConf.Refresh = JobStateRefreshFunc(apiClient, runActionResult.Results.Job.Links.Self.Href)
-				runProcessType = "job"
-			} else if runActionResult.Results.Order.Links.Self.Href != "" {
-				stateChangeConf.Pending = []string{"ACTIVE"}
-				stateChangeConf.Refresh = OrderStateRefreshFunc(apiClient, runActionResult.Results.Order.ID)
-				runProcessType = "order"
-			}
+	if actionPath == "" {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("CloudBolt resource does not have an action named \"%s\", this action is required to apply the change.", actionName),
+		}}
+	}
 
-			_, err := stateChangeConf.WaitForState()
-			if err != nil && runActionResult.Results.Job.Links.Self.Href != "" {
-				return diag.Errorf("Error waiting for Job (%s) to complete: %s", runActionResult.Results.Job.Links.Self.Href, err)
-			}
+	_, actionDiags := runResourceAction(apiClient, actionPath, resourceHref, parameters, requestTimeout, sensitiveParams)
+	return actionDiags
+}
 
-			if err != nil && runActionResult.Results.Order.Links.Self.Href != "" {
-				return diag.Errorf("Error waiting for Order (%s) to complete: %s", runActionResult.Results.Order.Links.Self.Href, err)
+// runResourceAction submits a CloudBolt resource action and waits for the Job or Order it starts to complete.
+// Values of sensitiveParams are masked in the returned diagnostics.
+func runResourceAction(apiClient *conns.CloudBoltClient, actionPath string, resourceHref string, parameters map[string]interface{}, requestTimeout int, sensitiveParams map[string]interface{}) (*cbclient.CloudBoltRunActionResult, diag.Diagnostics) {
+	runActionResult, err := apiClient.SubmitAction(actionPath, resourceHref, parameters)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if runActionResult.Results.Status != "" {
+		if runActionResult.Results.Status != "SUCCESS" {
+			var b strings.Builder
+
+			// First line (single line, status included)
+			fmt.Fprintf(
+				&b,
+				"Action failed (status=%s).\n\n",
+				runActionResult.Results.Status,
+			)
+
+			// Errors (multiline)
+			if strings.TrimSpace(runActionResult.Results.ErrorMessage) != "" {
+				b.WriteString("Errors:\n")
+				for _, line := range strings.Split(
+					strings.TrimRight(runActionResult.Results.ErrorMessage, "\n"),
+					"\n",
+				) {
+					fmt.Fprintf(&b, "  • %s\n", line)
+				}
+				b.WriteString("\n")
 			}
 
-			if err != nil {
-				return diag.Errorf(
-					"Timed out after %d minutes waiting for %s to complete. Error: %s",
-					requestTimeout,
-					runProcessType,
-					err,
-				)
+			// Output (multiline)
+			if strings.TrimSpace(runActionResult.Results.OutputMessage) != "" {
+				b.WriteString("Output:\n")
+				for _, line := range strings.Split(
+					strings.TrimRight(runActionResult.Results.OutputMessage, "\n"),
+					"\n",
+				) {
+					fmt.Fprintf(&b, "  • %s\n", line)
+				}
 			}
+
+			return nil, diag.Errorf("%s", redactSensitiveValues(b.String(), sensitiveParams))
+		}
+	} else {
+		stateChangeConf := resource.StateChangeConf{
+			Delay:   10 * time.Second,
+			Timeout: time.Duration(requestTimeout) * time.Minute,
+			Pending: []string{"INIT", "QUEUED", "PENDING", "RUNNING", "TO_CANCEL"},
+			Target:  []string{"SUCCESS"},
 		}
-	}
 
-	// Populate Terraform state by reading the resource
-	readDiags := resourceBPInstanceRead(ctx, d, m)
-	diags = append(diags, readDiags...)
+		var runProcessType string
+		if runActionResult.Results.Job.Links.Self.Href != "" {
+			stateChangeConf.Pending = []string{"INIT", "QUEUED", "PENDING", "RUNNING", "TO_CANCEL"}
+			stateChangeConf.Refresh = JobStateRefreshFunc(apiClient, runActionResult.Results.Job.Links.Self.Href)
+			runProcessType = "job"
+		} else if runActionResult.Results.Order.Links.Self.Href != "" {
+			stateChangeConf.Pending = []string{"ACTIVE"}
+			stateChangeConf.Refresh = OrderStateRefreshFunc(apiClient, runActionResult.Results.Order.ID)
+			runProcessType = "order"
+		}
 
-	return diags
+		_, err = stateChangeConf.WaitForState()
+		if err != nil && runActionResult.Results.Job.Links.Self.Href != "" {
+			return nil, diag.Errorf("Error waiting for Job (%s) to complete: %s", runActionResult.Results.Job.Links.Self.Href, redactSensitiveValues(err.Error(), sensitiveParams))
+		}
+
+		if err != nil && runActionResult.Results.Order.Links.Self.Href != "" {
+			return nil, diag.Errorf("Error waiting for Order (%s) to complete: %s", runActionResult.Results.Order.Links.Self.Href, redactSensitiveValues(err.Error(), sensitiveParams))
+		}
+
+		if err != nil {
+			return nil, diag.Errorf(
+				"Timed out after %d minutes waiting for %s to complete. Error: %s",
+				requestTimeout,
+				runProcessType,
+				redactSensitiveValues(err.Error(), sensitiveParams),
+			)
+		}
+	}
+
+	return runActionResult, nil
 }
 
 func resourceBPInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	defer withPanicRecovery(&diags, "Delete")
 
-	apiClient := m.(*cbclient.CloudBoltClient)
-	instanceType := d.Get("instance_type").(string)
+	apiClient := m.(*conns.CloudBoltClient)
+
+	instanceType, resourceHref, serverIds, err := parseBPInstanceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	requestTimeout := d.Get("request_timeout").(int)
-	if instanceType == "Resource" {
-		delActionPath, err := getResourceActionPath(apiClient, d.Id(), "Delete", false)
+
+	// The state is removed with the instance, so pre_destroy_action results are only logged.
+	actionResults, hookDiags := runActionHooks(apiClient, d, "pre_destroy_action", requestTimeout, getSensitiveParameters(d))
+	for _, actionResult := range actionResults {
+		log.Printf("[INFO] [provider.cloudbolt] pre_destroy_action \"%s\" %s", actionResult["name"], actionResult["status"])
+	}
+	diags = append(diags, hookDiags...)
+	if hookDiags.HasError() {
+		return diags
+	}
+
+	if instanceType == bpInstanceTypeResource {
+		delActionPath, err := getResourceActionPath(apiClient, resourceHref, "Delete", false)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
 		if delActionPath == "" {
-			return diag.Errorf("Error deleting resource (%s).", d.Id())
+			return diag.Errorf("Error deleting resource (%s).", resourceHref)
 		}
 
-		runActionResult, delerr := apiClient.SubmitAction(delActionPath, d.Id(), nil)
+		runActionResult, delerr := apiClient.SubmitAction(delActionPath, resourceHref, nil)
 		if delerr != nil {
 			return diag.FromErr(delerr)
 		}
@@ -847,8 +1334,6 @@ func resourceBPInstanceDelete(ctx context.Context, d *schema.ResourceData, m int
 			}
 		}
 	} else {
-		serverIds := strings.Split(d.Id(), "_")
-
 		for _, serverId := range serverIds {
 			decomResult, err := apiClient.DecomServer(serverId)
 			if err != nil {
@@ -897,7 +1382,7 @@ func withPanicRecovery(
 	}
 }
 
-func OrderStateRefreshFunc(apiClient *cbclient.CloudBoltClient, orderId string) resource.StateRefreshFunc {
+func OrderStateRefreshFunc(apiClient *conns.CloudBoltClient, orderId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		order, err := apiClient.GetOrder(orderId)
 
@@ -950,7 +1435,7 @@ func OrderStateRefreshFunc(apiClient *cbclient.CloudBoltClient, orderId string)
 	}
 }
 
-func JobStateRefreshFunc(apiClient *cbclient.CloudBoltClient, jobPath string) resource.StateRefreshFunc {
+func JobStateRefreshFunc(apiClient *conns.CloudBoltClient, jobPath string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		job, err := apiClient.GetJob(jobPath, false)
 		if err != nil {
@@ -996,7 +1481,7 @@ func JobStateRefreshFunc(apiClient *cbclient.CloudBoltClient, jobPath string) re
 	}
 }
 
-func getResourceActionPath(apiClient *cbclient.CloudBoltClient, resourcePath string, resourceActionName string, prefixFilter bool) (string, error) {
+func getResourceActionPath(apiClient *conns.CloudBoltClient, resourcePath string, resourceActionName string, prefixFilter bool) (string, error) {
 	var actionPath string
 	res, err := apiClient.GetResource(resourcePath)
 	if err != nil {
@@ -1040,3 +1525,489 @@ func normalizeParameters(params map[string]interface{}) map[string]interface{} {
 
 	return normalizedParams
 }
+
+func getSensitiveParameters(d *schema.ResourceData) map[string]interface{} {
+	sensitiveParams, ok := d.Get("sensitive_parameters").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return sensitiveParams
+}
+
+// mergeParameters returns a copy of params with overrides applied on top.
+func mergeParameters(params map[string]interface{}, overrides map[string]interface{}) map[string]interface{} {
+	mergedParams := make(map[string]interface{}, len(params)+len(overrides))
+
+	for k, v := range params {
+		mergedParams[k] = v
+	}
+
+	for k, v := range overrides {
+		mergedParams[k] = v
+	}
+
+	return mergedParams
+}
+
+// removeSensitiveAttributes drops the attributes that were ordered as sensitive parameters
+// so CloudBolt never echoes their values back into state.
+func removeSensitiveAttributes(attributes map[string]interface{}, sensitiveParams map[string]interface{}) {
+	for k := range sensitiveParams {
+		delete(attributes, k)
+	}
+}
+
+// redactSensitiveValues masks every sensitive parameter value found in message.
+// List values ("[a|b]") are masked both as a whole and item by item.
+func redactSensitiveValues(message string, sensitiveParams map[string]interface{}) string {
+	values := make([]string, 0)
+	for _, v := range sensitiveParams {
+		value, ok := v.(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		values = append(values, value)
+		if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			for _, item := range strings.Split(value[1:len(value)-1], "|") {
+				if item != "" {
+					values = append(values, item)
+				}
+			}
+		}
+	}
+
+	// Replace longer values first so a value containing another is fully masked.
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	for _, value := range values {
+		message = strings.ReplaceAll(message, value, "(sensitive value)")
+	}
+
+	return message
+}
+
+// parseDate parses a date in any of the dateLayouts.
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, strings.TrimSpace(value))
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
+// dateInRange parses a date and reports whether it is at or after after and before before, a zero bound is not checked.
+// Dates that cannot be parsed are only in range when neither bound is set.
+func dateInRange(value string, after time.Time, before time.Time) (time.Time, bool) {
+	date, err := parseDate(value)
+	if err != nil {
+		return date, after.IsZero() && before.IsZero()
+	}
+
+	if !after.IsZero() && date.Before(after) {
+		return date, false
+	}
+
+	if !before.IsZero() && !date.Before(before) {
+		return date, false
+	}
+
+	return date, true
+}
+
+// validateDate checks that a date argument is in one of the dateLayouts.
+func validateDate(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, err := parseDate(v); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a date like \"2026-12-31\" or \"2026-12-31T17:00:00\", got: %s", key, v))
+	}
+
+	return warns, errs
+}
+
+// suppressEquivalentExpirationDate ignores differences in how the same date is formatted,
+// e.g. "2026-12-31" in the configuration and "2026-12-31 00:00:00" from CloudBolt.
+func suppressEquivalentExpirationDate(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := parseDate(old)
+	if err != nil {
+		return false
+	}
+
+	newDate, err := parseDate(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDate.Equal(newDate)
+}
+
+// setGroupAndOwner records the current group and owner so moves made outside Terraform show as drift.
+func setGroupAndOwner(d *schema.ResourceData, groupHref string, ownerHref string) {
+	if groupHref != "" {
+		d.Set("group", groupHref)
+	}
+
+	if ownerHref != "" {
+		d.Set("owner", ownerHref)
+	}
+}
+
+// suppressEquivalentHref ignores differences between API paths to the same object, e.g.
+// "/api/v3/cmp/groups/GRP-abcd1234/" and "/api/v3/cloudbolt/groups/GRP-abcd1234".
+func suppressEquivalentHref(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	return equivalentHref(old, new)
+}
+
+// equivalentHref reports whether an API URL path refers to the given API URL path or global id.
+func equivalentHref(href string, pathOrId string) bool {
+	if href == "" {
+		return false
+	}
+
+	return hrefId(href) == hrefId(pathOrId)
+}
+
+// hrefId returns the global id of an API URL path, global ids are returned as is.
+func hrefId(pathOrId string) string {
+	return path.Base(strings.TrimRight(pathOrId, "/"))
+}
+
+// runActionHooks runs the resource actions of a post_create_action or pre_destroy_action list in order.
+// Failed actions with fail_on_error stop the list and return an error, the others return a warning.
+func runActionHooks(apiClient *conns.CloudBoltClient, d *schema.ResourceData, hookKey string, requestTimeout int, sensitiveParams map[string]interface{}) ([]map[string]interface{}, diag.Diagnostics) {
+	hooks := d.Get(hookKey).([]interface{})
+	if len(hooks) == 0 {
+		return make([]map[string]interface{}, 0), nil
+	}
+
+	instanceType, resourceHref, _, err := parseBPInstanceID(d.Id())
+	if err != nil {
+		return make([]map[string]interface{}, 0), diag.FromErr(err)
+	}
+
+	if instanceType != bpInstanceTypeResource {
+		return make([]map[string]interface{}, 0), diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s is only supported when the blueprint deploys a Resource, the actions were not run.", hookKey),
+		}}
+	}
+
+	return runActionHookList(hookKey, hooks, func(name string, parameters map[string]interface{}) (string, string, diag.Diagnostics) {
+		actionPath, err := getResourceActionPath(apiClient, resourceHref, name, false)
+		if err == nil && actionPath == "" {
+			err = fmt.Errorf("CloudBolt resource does not have an action named \"%s\"", name)
+		}
+		if err != nil {
+			return "", "", diag.FromErr(err)
+		}
+
+		runActionResult, actionDiags := runResourceAction(apiClient, actionPath, resourceHref, normalizeParameters(parameters), requestTimeout, sensitiveParams)
+		if runActionResult == nil {
+			return "", "", actionDiags
+		}
+
+		output, errorMessages := actionResultMessages(apiClient, runActionResult)
+		return redactSensitiveValues(output, sensitiveParams), redactSensitiveValues(errorMessages, sensitiveParams), actionDiags
+	})
+}
+
+// actionHookRunner runs a single resource action and returns its output, its errors and the diagnostics of the run.
+type actionHookRunner func(name string, parameters map[string]interface{}) (string, string, diag.Diagnostics)
+
+// runActionHookList runs hooks in order with run and records a result for every hook that ran.
+func runActionHookList(hookKey string, hooks []interface{}, run actionHookRunner) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	actionResults := make([]map[string]interface{}, 0, len(hooks))
+
+	for _, v := range hooks {
+		hook := v.(map[string]interface{})
+		name := hook["name"].(string)
+		failOnError := hook["fail_on_error"].(bool)
+		parameters, _ := hook["parameters"].(map[string]interface{})
+
+		output, errorMessages, actionDiags := run(name, parameters)
+		actionResult := map[string]interface{}{
+			"name":   name,
+			"status": "SUCCESS",
+			"output": output,
+			"error":  errorMessages,
+		}
+
+		if !actionDiags.HasError() {
+			actionResults = append(actionResults, actionResult)
+			continue
+		}
+
+		actionResult["status"] = "FAILURE"
+		if errorMessages == "" {
+			actionResult["error"] = actionDiags[0].Summary
+		}
+		actionResults = append(actionResults, actionResult)
+
+		log.Printf("[WARN] [provider.cloudbolt] %s \"%s\" failed: %s", hookKey, name, actionResult["error"])
+
+		if failOnError {
+			return actionResults, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s \"%s\" failed", hookKey, name),
+				Detail:   actionDiags[0].Summary,
+			})
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s \"%s\" failed, continuing because fail_on_error is false", hookKey, name),
+			Detail:   actionDiags[0].Summary,
+		})
+	}
+
+	return actionResults, diags
+}
+
+// actionResultMessages returns the output and errors of a resource action,
+// fetching them from the Job when the action ran asynchronously.
+func actionResultMessages(apiClient *conns.CloudBoltClient, runActionResult *cbclient.CloudBoltRunActionResult) (string, string) {
+	if runActionResult.Results.Status != "" || runActionResult.Results.Job.Links.Self.Href == "" {
+		return runActionResult.Results.OutputMessage, runActionResult.Results.ErrorMessage
+	}
+
+	job, err := apiClient.GetJob(runActionResult.Results.Job.Links.Self.Href, false)
+	if err != nil {
+		return "", ""
+	}
+
+	return job.Output, job.Errors
+}
+
+// waitForServers polls the deployed Servers until every one meets the "wait_for" conditions.
+func waitForServers(apiClient *conns.CloudBoltClient, d *schema.ResourceData) diag.Diagnostics {
+	waitFor := d.Get("wait_for").([]interface{})
+	if len(waitFor) == 0 || waitFor[0] == nil {
+		return nil
+	}
+
+	conditions := waitFor[0].(map[string]interface{})
+	timeout := conditions["timeout"].(int)
+
+	stateChangeConf := resource.StateChangeConf{
+		Delay:        5 * time.Second,
+		Timeout:      time.Duration(timeout) * time.Minute,
+		PollInterval: 10 * time.Second,
+		Pending:      []string{"WAITING"},
+		Target:       []string{"READY"},
+		Refresh:      ServerReadinessRefreshFunc(apiClient, d.Id(), conditions),
+	}
+
+	_, err := stateChangeConf.WaitForState()
+	if err != nil {
+		return diag.Errorf("Error waiting for Servers to be ready: %s", err)
+	}
+
+	return nil
+}
+
+// ServerReadinessRefreshFunc reports "READY" once every Server of the bp_instance meets the conditions,
+// otherwise "WAITING" with the reason of the first Server that does not.
+func ServerReadinessRefreshFunc(apiClient *conns.CloudBoltClient, id string, conditions map[string]interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		svrs, err := getBPInstanceServers(apiClient, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, svr := range svrs {
+			if reason := serverNotReadyReason(svr, conditions); reason != "" {
+				log.Printf("[DEBUG] [provider.cloudbolt] Server (%s) is not ready: %s", svr.Hostname, reason)
+				return svrs, "WAITING", nil
+			}
+
+			port, _ := conditions["port"].(int)
+			if port > 0 {
+				conn, err := net.DialTimeout("tcp", net.JoinHostPort(svr.IP, strconv.Itoa(port)), 5*time.Second)
+				if err != nil {
+					log.Printf("[DEBUG] [provider.cloudbolt] Server (%s) port %d is not reachable: %s", svr.Hostname, port, err)
+					return svrs, "WAITING", nil
+				}
+				conn.Close()
+			}
+		}
+
+		return svrs, "READY", nil
+	}
+}
+
+// serverNotReadyReason returns why a Server does not meet the power status and IP conditions, or "" when it does.
+// A port check needs an IP address, so it implies ip_assigned.
+func serverNotReadyReason(svr *cbclient.CloudBoltServer, conditions map[string]interface{}) string {
+	powerStatus, _ := conditions["power_status"].(string)
+	if powerStatus != "" && !strings.EqualFold(svr.PowerStatus, powerStatus) {
+		return fmt.Sprintf("power status is %q, waiting for %q", svr.PowerStatus, powerStatus)
+	}
+
+	ipAssigned, _ := conditions["ip_assigned"].(bool)
+	port, _ := conditions["port"].(int)
+	if (ipAssigned || port > 0) && svr.IP == "" {
+		return "no IP address assigned"
+	}
+
+	return ""
+}
+
+// getBPInstanceServers returns the current Servers of a bp_instance.
+func getBPInstanceServers(apiClient *conns.CloudBoltClient, id string) ([]*cbclient.CloudBoltServer, error) {
+	instanceType, resourceHref, serverIds, err := parseBPInstanceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	svrs := make([]*cbclient.CloudBoltServer, 0)
+	if instanceType == bpInstanceTypeResource {
+		res, err := apiClient.GetResource(resourceHref)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range res.Links.Servers {
+			svr, err := apiClient.GetServer(s.Href)
+			if err != nil {
+				return nil, err
+			}
+			svrs = append(svrs, svr)
+		}
+
+		return svrs, nil
+	}
+
+	for _, serverId := range serverIds {
+		svr, err := apiClient.GetServerById(serverId)
+		if err != nil {
+			return nil, err
+		}
+		svrs = append(svrs, svr)
+	}
+
+	return svrs, nil
+}
+
+// resourceBPInstanceCustomizeDiff rejects changes Servers cannot apply and enforces the provider guardrails at plan time.
+// Values unknown during plan are checked when Terraform plans again at apply time.
+func resourceBPInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if instanceType, _, _, err := parseBPInstanceID(d.Id()); err == nil && instanceType != bpInstanceTypeResource {
+		for _, k := range []string{"parameters", "sensitive_parameters", "deployment_item", "expiration_date", "group", "owner"} {
+			if d.HasChange(k) {
+				return fmt.Errorf("Cannot change %s of blueprint instance (%s): the CloudBolt provider does not support Terraform config updates for Servers", k, d.Id())
+			}
+		}
+	}
+
+	apiClient, ok := m.(*conns.CloudBoltClient)
+	if !ok || apiClient == nil {
+		return nil
+	}
+	guardrails := apiClient.Guardrails
+
+	isNew := d.Id() == ""
+	if len(guardrails.AllowedGroups) > 0 && (isNew || d.HasChange("group")) && d.NewValueKnown("group") {
+		groupHref := d.Get("group").(string)
+		allowed, err := groupAllowed(apiClient, groupHref, guardrails.AllowedGroups)
+		if err != nil {
+			return err
+		}
+
+		if !allowed {
+			return fmt.Errorf("Group (%s) is not allowed by the provider guardrails, allowed groups: %s", groupHref, strings.Join(guardrails.AllowedGroups, ", "))
+		}
+	}
+
+	if len(guardrails.AllowedBlueprintIDs) > 0 && (isNew || d.HasChange("blueprint_id")) && d.NewValueKnown("blueprint_id") {
+		blueprintId := d.Get("blueprint_id").(string)
+		if !containsString(guardrails.AllowedBlueprintIDs, blueprintId) {
+			return fmt.Errorf("Blueprint (%s) is not allowed by the provider guardrails, allowed blueprints: %s", blueprintId, strings.Join(guardrails.AllowedBlueprintIDs, ", "))
+		}
+	}
+
+	rateKeys := []string{"group", "blueprint_id", "parameters", "sensitive_parameters", "deployment_item"}
+	if guardrails.MaxMonthlyRate > 0 && (isNew || d.HasChanges("parameters", "sensitive_parameters", "deployment_item")) {
+		// The estimate is a POST, read_only providers never call it and cannot apply the order anyway.
+		if apiClient.ReadOnly {
+			log.Printf("[WARN] [provider.cloudbolt] max_monthly_rate is not checked for %s: the provider is configured with read_only", d.Get("blueprint_id").(string))
+			return nil
+		}
+
+		for _, k := range rateKeys {
+			if !d.NewValueKnown(k) {
+				log.Printf("[WARN] [provider.cloudbolt] max_monthly_rate is not checked during this plan: %s is not known until apply", k)
+				return nil
+			}
+		}
+
+		bpParams := mergeParameters(normalizeParameters(d.Get("parameters").(map[string]interface{})), normalizeParameters(d.Get("sensitive_parameters").(map[string]interface{})))
+		bpItems := expandDeploymentItems(d.Get("deployment_item").(*schema.Set).List())
+		blueprintId := d.Get("blueprint_id").(string)
+
+		estimate, err := estimateOrder(apiClient, blueprintId, deployBlueprintRequest(d.Get("group").(string), bpParams, bpItems))
+		if err != nil {
+			return err
+		}
+
+		rate, rateValue, rateTimeUnit := parseRate(estimate.Rate)
+		rateMonthly, ok := monthlyRate(rateValue, rateTimeUnit)
+		if !ok {
+			return fmt.Errorf("Estimated rate (%s) for Blueprint (%s) cannot be compared to the provider guardrails max_monthly_rate", rate, blueprintId)
+		}
+
+		if rateMonthly > guardrails.MaxMonthlyRate {
+			return fmt.Errorf("Estimated monthly rate (%.2f, from %s) for Blueprint (%s) exceeds the provider guardrails max_monthly_rate (%.2f)", rateMonthly, rate, blueprintId, guardrails.MaxMonthlyRate)
+		}
+	}
+
+	return nil
+}
+
+// groupAllowed reports whether a Group API path matches one of the allowed API paths, global ids or names.
+// The Group is only fetched when names need to be compared.
+func groupAllowed(apiClient *conns.CloudBoltClient, groupHref string, allowedGroups []string) (bool, error) {
+	groupId := path.Base(strings.TrimRight(groupHref, "/"))
+
+	var names []string
+	for _, allowed := range allowedGroups {
+		if strings.Contains(allowed, "/api/") || strings.HasPrefix(allowed, "GRP-") {
+			if equivalentHref(groupHref, allowed) {
+				return true, nil
+			}
+			continue
+		}
+		names = append(names, allowed)
+	}
+
+	if len(names) == 0 {
+		return false, nil
+	}
+
+	group, err := apiClient.GetGroupById(groupId)
+	if err != nil {
+		return false, fmt.Errorf("Error getting Group (%s) to check the provider guardrails: %s", groupHref, err)
+	}
+
+	return containsString(names, group.Name), nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,297 @@
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	bpInstanceTypeResource = "Resource"
+	bpInstanceTypeServer   = "Server"
+
+	bpInstanceIDResourcePrefix = "resource:"
+	bpInstanceIDServersPrefix  = "servers:"
+)
+
+// bpInstanceResourceID builds the state ID for a blueprint instance that deployed a Resource.
+// e.g., "resource:/api/v3/cmp/resources/RSC-abcd1234/"
+func bpInstanceResourceID(resourceHref string) string {
+	return bpInstanceIDResourcePrefix + resourceHref
+}
+
+// bpInstanceServersID builds the state ID for a blueprint instance that deployed Servers.
+// e.g., "servers:SVR-abcd1234,SVR-efgh5678"
+func bpInstanceServersID(serverIds []string) string {
+	return bpInstanceIDServersPrefix + strings.Join(serverIds, ",")
+}
+
+// parseBPInstanceID splits a blueprint instance state ID into the instance type
+// and either the Resource path or the list of Server ids.
+func parseBPInstanceID(id string) (string, string, []string, error) {
+	switch {
+	case strings.HasPrefix(id, bpInstanceIDResourcePrefix):
+		resourceHref := strings.TrimPrefix(id, bpInstanceIDResourcePrefix)
+		if resourceHref == "" {
+			return "", "", nil, fmt.Errorf("Invalid blueprint instance ID (%s): missing resource path", id)
+		}
+
+		return bpInstanceTypeResource, resourceHref, nil, nil
+	case strings.HasPrefix(id, bpInstanceIDServersPrefix):
+		serverIds := strings.Split(strings.TrimPrefix(id, bpInstanceIDServersPrefix), ",")
+		for _, serverId := range serverIds {
+			if serverId == "" {
+				return "", "", nil, fmt.Errorf("Invalid blueprint instance ID (%s): empty server id", id)
+			}
+		}
+
+		return bpInstanceTypeServer, "", serverIds, nil
+	}
+
+	return "", "", nil, fmt.Errorf(
+		"Invalid blueprint instance ID (%s), expected \"%s<href>\" or \"%s<id>,<id>\"",
+		id,
+		bpInstanceIDResourcePrefix,
+		bpInstanceIDServersPrefix,
+	)
+}
+
+// resourceBPInstanceStateUpgradeV0 converts the version 0 ID, which was either the
+// Resource path or the Server ids joined with "_", into the self-describing ID.
+func resourceBPInstanceStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	id, _ := rawState["id"].(string)
+	if id == "" {
+		return rawState, nil
+	}
+
+	// Version 0 treated every instance that was not a Resource as Servers.
+	instanceType, _ := rawState["instance_type"].(string)
+	if instanceType == bpInstanceTypeResource {
+		rawState["id"] = bpInstanceResourceID(id)
+		rawState["resource_href"] = id
+	} else {
+		serverIds := strings.Split(id, "_")
+		rawState["id"] = bpInstanceServersID(serverIds)
+		rawState["instance_type"] = bpInstanceTypeServer
+
+		ids := make([]interface{}, len(serverIds))
+		for i, serverId := range serverIds {
+			ids[i] = serverId
+		}
+		rawState["server_ids"] = ids
+	}
+
+	return rawState, nil
+}
+
+// resourceBPInstanceV0 is the schema of cloudbolt_bp_instance before the state ID was made self-describing.
+func resourceBPInstanceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The relative API URL path for the CloudBolt Group",
+			},
+			"blueprint_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The global Id for the CloudBolt Blueprint",
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Parameters Name/Value pair",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name for the created CloudBolt Resoucce",
+			},
+			"request_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Timeout in minutes, Default (30)",
+			},
+			"deployment_item": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of blueprint deployment items",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The reference name for the blueprint deployment item",
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The relative API URL path for the CloudBolt Environment",
+						},
+						"osbuild": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The relative API URL path for the CloudBolt OS Build",
+						},
+						"parameters": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Parameter Name/Value pair",
+						},
+					},
+				},
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server Hostname",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server IP Address",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CloudBolt Server Status",
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server MAC Address",
+						},
+						"power_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server Power Status",
+						},
+						"date_added_to_cloudbolt": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date the server was added to CloudBolt",
+						},
+						"cpu_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "CPU Count",
+						},
+						"memory_size_gb": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Total Memory in GB",
+						},
+						"disk_size_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total Disk Size in GB",
+						},
+						"notes": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server Notes",
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Server Labels",
+						},
+						"os_family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server OS Family",
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "CloudBolt Server attributes",
+						},
+						"rate_breakdown": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Server Rate Breakdown",
+						},
+						"tech_specific_attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Resource Handler technical specific attributes",
+						},
+						"disks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uuid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Unique ID of Disk",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of Disk",
+									},
+									"disk_size_gb": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Disk Size in GB",
+									},
+								},
+							},
+							Description: "Server disks",
+						},
+						"networks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeMap,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							Description: "Server NICs",
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of deployed instance Resource or Server",
+			},
+			"attributes": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "CloudBolt Resource attributes",
+			},
+		},
+	}
+}
@@ -1,11 +1,55 @@
 package cmp
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// newTestClient returns a CloudBoltClient that sends its JSON requests to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *conns.CloudBoltClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, _ := url.Parse(server.URL)
+	return conns.New("http", serverURL.Hostname(), serverURL.Port(), "user", "pass", "", server.Client())
+}
+
+// testRequest is a request received by a newTestClient handler.
+type testRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   map[string]interface{}
+}
+
+// recordRequest decodes a request received by a newTestClient handler.
+func recordRequest(t *testing.T, r *http.Request) testRequest {
+	req := testRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query()}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &req.Body); err != nil {
+			t.Errorf("request body is not a JSON object: %s", body)
+		}
+	}
+
+	return req
+}
+
 func TestWithPanicRecovery_RecoversAndAddsDiagnostic(t *testing.T) {
 	var diags diag.Diagnostics
 
@@ -50,3 +94,402 @@ func TestWithPanicRecovery_NoPanic_NoDiagnostic(t *testing.T) {
 	}
 }
 
+func TestParseBPInstanceID(t *testing.T) {
+	instanceType, resourceHref, serverIds, err := parseBPInstanceID("resource:/api/v3/cmp/resources/RSC-abcd1234/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if instanceType != "Resource" || resourceHref != "/api/v3/cmp/resources/RSC-abcd1234/" || serverIds != nil {
+		t.Errorf("unexpected resource ID parse: %q %q %v", instanceType, resourceHref, serverIds)
+	}
+
+	instanceType, resourceHref, serverIds, err = parseBPInstanceID("servers:SVR-abcd1234,SVR-efgh5678")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if instanceType != "Server" || resourceHref != "" || len(serverIds) != 2 || serverIds[1] != "SVR-efgh5678" {
+		t.Errorf("unexpected servers ID parse: %q %q %v", instanceType, resourceHref, serverIds)
+	}
+
+	for _, id := range []string{"", "/api/v3/cmp/resources/RSC-abcd1234/", "resource:", "servers:", "servers:SVR-abcd1234,"} {
+		if _, _, _, err := parseBPInstanceID(id); err == nil {
+			t.Errorf("expected error for ID %q", id)
+		}
+	}
+}
+
+func TestResourceBPInstanceStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":            "/api/v3/cmp/resources/RSC-abcd1234/",
+		"instance_type": "Resource",
+	}
+
+	upgraded, err := resourceBPInstanceStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if upgraded["id"] != "resource:/api/v3/cmp/resources/RSC-abcd1234/" {
+		t.Errorf("unexpected id: %v", upgraded["id"])
+	}
+
+	if upgraded["resource_href"] != "/api/v3/cmp/resources/RSC-abcd1234/" {
+		t.Errorf("unexpected resource_href: %v", upgraded["resource_href"])
+	}
+
+	rawState = map[string]interface{}{
+		"id":            "SVR-abcd1234_SVR-efgh5678",
+		"instance_type": "Server",
+	}
+
+	upgraded, err = resourceBPInstanceStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if upgraded["id"] != "servers:SVR-abcd1234,SVR-efgh5678" {
+		t.Errorf("unexpected id: %v", upgraded["id"])
+	}
+
+	serverIds, _ := upgraded["server_ids"].([]interface{})
+	if len(serverIds) != 2 || serverIds[0] != "SVR-abcd1234" {
+		t.Errorf("unexpected server_ids: %v", upgraded["server_ids"])
+	}
+}
+
+func TestRedactSensitiveValues(t *testing.T) {
+	sensitiveParams := map[string]interface{}{
+		"admin_password": "s3cret",
+		"api_keys":       "[key-one|key-two]",
+		"empty":          "",
+	}
+
+	message := "Order failed.\n  • login with s3cret failed\n  • invalid key key-two\n"
+	redacted := redactSensitiveValues(message, sensitiveParams)
+
+	expected := "Order failed.\n  • login with (sensitive value) failed\n  • invalid key (sensitive value)\n"
+	if redacted != expected {
+		t.Errorf("expected %q, got %q", expected, redacted)
+	}
+}
+
+func TestParseServerNICsAndDisks(t *testing.T) {
+	nics := parseServerNICs([]map[string]interface{}{
+		{
+			"name":      "NIC 1",
+			"network":   "subnet-214ab049",
+			"mac":       "02:99:e2:0f:18:b2",
+			"ip":        "3.17.176.215",
+			"privateIp": "172.31.13.128",
+		},
+		{
+			"name":    "NIC 2",
+			"network": "subnet-9f0c1d2e",
+			"ip":      nil,
+		},
+	})
+
+	if len(nics) != 2 {
+		t.Fatalf("expected 2 nics, got %d", len(nics))
+	}
+
+	if nics[0]["ip"] != "3.17.176.215" || nics[0]["private_ip"] != "172.31.13.128" || nics[0]["primary"] != true {
+		t.Errorf("unexpected first nic: %v", nics[0])
+	}
+
+	if nics[1]["ip"] != "" || nics[1]["primary"] != false {
+		t.Errorf("unexpected second nic: %v", nics[1])
+	}
+
+	disks := parseServerDisks([]map[string]interface{}{
+		{"uuid": "vol-1", "name": "Hard disk 1", "diskSize": float64(40), "datastore": "ds-01", "provisioningType": "thin"},
+		{"uuid": "vol-2", "name": "Hard disk 2", "diskSize": "100"},
+		{"uuid": "vol-3", "name": "Hard disk 3"},
+	})
+
+	for i, expected := range []int{40, 100, 0} {
+		if disks[i]["disk_size_gb"] != expected {
+			t.Errorf("disk %d: expected size %d, got %v", i, expected, disks[i]["disk_size_gb"])
+		}
+	}
+
+	if disks[0]["datastore"] != "ds-01" || disks[0]["provisioning_type"] != "thin" {
+		t.Errorf("unexpected first disk: %v", disks[0])
+	}
+}
+
+func TestParseAttributesJSON(t *testing.T) {
+	attributes := []map[string]interface{}{
+		{"name": "replicas", "value": float64(1000000)},
+		{"name": "tags", "value": []interface{}{"web", "prod"}},
+		{"name": "config", "value": map[string]interface{}{"port": float64(443)}},
+		{"name": "enabled", "value": true},
+	}
+
+	attributesJSON, err := parseAttributesJSON(attributes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"replicas": "1000000",
+		"tags":     `["web","prod"]`,
+		"config":   `{"port":443}`,
+		"enabled":  "true",
+	}
+
+	for k, v := range expected {
+		if attributesJSON[k] != v {
+			t.Errorf("attribute %s: expected %s, got %v", k, v, attributesJSON[k])
+		}
+	}
+}
+
+func TestConvertValueToPlainString(t *testing.T) {
+	for value, expected := range map[interface{}]string{
+		1000000.0: "1000000",
+		0.0:       "0",
+		2.5:       "2.5",
+		"":        "",
+		true:      "true",
+	} {
+		if got := convertValueToPlainString(value); got != expected {
+			t.Errorf("%v: expected %q, got %q", value, expected, got)
+		}
+	}
+}
+
+func TestSuppressEquivalentExpirationDate(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"2026-12-31 00:00:00", "2026-12-31", true},
+		{"2026-12-31T17:00:00", "2026-12-31 17:00:00", true},
+		{"2027-01-31 00:00:00", "2026-12-31", false},
+		{"", "2026-12-31", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressEquivalentExpirationDate("expiration_date", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("%q -> %q: expected suppress=%t, got %t", c.old, c.new, c.suppress, got)
+		}
+	}
+}
+
+func TestServerNotReadyReason(t *testing.T) {
+	cases := []struct {
+		name       string
+		server     cbclient.CloudBoltServer
+		conditions map[string]interface{}
+		ready      bool
+	}{
+		{"no conditions", cbclient.CloudBoltServer{}, map[string]interface{}{}, true},
+		{"powered off", cbclient.CloudBoltServer{PowerStatus: "POWEROFF"}, map[string]interface{}{"power_status": "POWERON"}, false},
+		{"powered on", cbclient.CloudBoltServer{PowerStatus: "poweron"}, map[string]interface{}{"power_status": "POWERON"}, true},
+		{"missing ip", cbclient.CloudBoltServer{}, map[string]interface{}{"ip_assigned": true}, false},
+		{"port implies ip", cbclient.CloudBoltServer{}, map[string]interface{}{"port": 22}, false},
+		{"ip assigned", cbclient.CloudBoltServer{IP: "10.0.0.5"}, map[string]interface{}{"ip_assigned": true, "port": 0}, true},
+	}
+
+	for _, c := range cases {
+		reason := serverNotReadyReason(&c.server, c.conditions)
+		if (reason == "") != c.ready {
+			t.Errorf("%s: expected ready=%t, got reason %q", c.name, c.ready, reason)
+		}
+	}
+}
+
+func TestParseRateMonthly(t *testing.T) {
+	cases := []struct {
+		rate    interface{}
+		monthly float64
+	}{
+		{"4.18/month", 4.18},
+		{"$1,200.00/year", 100},
+		{"0.5/hour", 365},
+		{12.5, 12.5},
+	}
+
+	for _, c := range cases {
+		_, value, unit := parseRate(c.rate)
+		monthly, ok := monthlyRate(value, unit)
+		if !ok || monthly < c.monthly-0.001 || monthly > c.monthly+0.001 {
+			t.Errorf("%v: expected monthly rate %g, got %g (%t)", c.rate, c.monthly, monthly, ok)
+		}
+	}
+
+	if _, ok := monthlyRate(1, "fortnight"); ok {
+		t.Error("expected unknown time unit to be rejected")
+	}
+}
+
+func TestGroupAllowedByHrefAndId(t *testing.T) {
+	allowedGroups := []string{"/api/v3/cmp/groups/GRP-aaaa1111/", "GRP-bbbb2222"}
+
+	for href, expected := range map[string]bool{
+		"/api/v3/cmp/groups/GRP-aaaa1111/": true,
+		"/api/v3/cmp/groups/GRP-bbbb2222":  true,
+		"/api/v3/cmp/groups/GRP-cccc3333/": false,
+	} {
+		allowed, err := groupAllowed(nil, href, allowedGroups)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", href, err)
+		}
+
+		if allowed != expected {
+			t.Errorf("%s: expected allowed=%t", href, expected)
+		}
+	}
+}
+
+func TestRunNamedResourceAction(t *testing.T) {
+	requests := make([]testRequest, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, recordRequest(t, r))
+		switch r.URL.Path {
+		case "/api/v3/cmp/resources/RSC-abcd1234/":
+			io.WriteString(w, `{"_links": {"actions": [{"title": "Change Group", "href": "/api/v3/cmp/resourceActions/RSA-1/"}]}}`)
+		case "/api/v3/cmp/resourceActions/RSA-1/runAction/":
+			io.WriteString(w, `{"results": {"status": "SUCCESS"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	diags := runNamedResourceAction(client, "/api/v3/cmp/resources/RSC-abcd1234/", changeGroupAction, map[string]interface{}{
+		groupParameter: "/api/v3/cmp/groups/GRP-abcd1234/",
+	}, 1, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+
+	run := requests[1]
+	parameters, _ := run.Body["parameters"].(map[string]interface{})
+	if run.Method != http.MethodPost || run.Body["resource"] != "/api/v3/cmp/resources/RSC-abcd1234/" || parameters["group"] != "/api/v3/cmp/groups/GRP-abcd1234/" {
+		t.Errorf("unexpected run action request %+v", run)
+	}
+
+	diags = runNamedResourceAction(client, "/api/v3/cmp/resources/RSC-abcd1234/", changeOwnerAction, map[string]interface{}{
+		ownerParameter: "/api/v3/cloudbolt/users/USR-abcd1234/",
+	}, 1, nil)
+	if !diags.HasError() || len(requests) != 3 {
+		t.Errorf("expected a missing action to fail without running anything, got %v", diags)
+	}
+}
+
+func TestRunActionHookList(t *testing.T) {
+	hooks := []interface{}{
+		map[string]interface{}{"name": "Register in Monitoring", "fail_on_error": false, "parameters": map[string]interface{}{"tier": "gold"}},
+		map[string]interface{}{"name": "Apply Baseline", "fail_on_error": true, "parameters": map[string]interface{}{}},
+		map[string]interface{}{"name": "Never Run", "fail_on_error": true, "parameters": map[string]interface{}{}},
+	}
+
+	ran := make([]string, 0)
+	results, diags := runActionHookList("post_create_action", hooks, func(name string, parameters map[string]interface{}) (string, string, diag.Diagnostics) {
+		ran = append(ran, name)
+
+		switch name {
+		case "Register in Monitoring":
+			if parameters["tier"] != "gold" {
+				t.Errorf("expected the hook parameters, got %v", parameters)
+			}
+			return "", "", diag.Errorf("monitoring is down")
+		case "Apply Baseline":
+			return "partial output", "baseline failed", diag.Errorf("Action failed (status=FAILURE).")
+		}
+
+		return "", "", nil
+	})
+
+	if len(ran) != 2 || ran[0] != "Register in Monitoring" || ran[1] != "Apply Baseline" {
+		t.Fatalf("expected the hooks to run in order and stop at the failed fail_on_error hook, ran %v", ran)
+	}
+
+	if len(diags) != 2 || diags[0].Severity != diag.Warning || diags[1].Severity != diag.Error {
+		t.Fatalf("expected a warning then an error, got %v", diags)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected a result per hook that ran, got %v", results)
+	}
+
+	if results[0]["status"] != "FAILURE" || results[0]["error"] != "monitoring is down" {
+		t.Errorf("expected the diagnostic as error when the action reports none, got %v", results[0])
+	}
+
+	if results[1]["status"] != "FAILURE" || results[1]["error"] != "baseline failed" || results[1]["output"] != "partial output" {
+		t.Errorf("expected the action output and errors to be recorded, got %v", results[1])
+	}
+}
+
+func TestRunActionHookListSuccess(t *testing.T) {
+	hooks := []interface{}{
+		map[string]interface{}{"name": "Apply Baseline", "fail_on_error": true, "parameters": map[string]interface{}{}},
+	}
+
+	results, diags := runActionHookList("post_create_action", hooks, func(name string, parameters map[string]interface{}) (string, string, diag.Diagnostics) {
+		return "baseline applied", "", nil
+	})
+
+	if diags.HasError() || len(diags) != 0 {
+		t.Errorf("unexpected diagnostics %v", diags)
+	}
+
+	if len(results) != 1 || results[0]["status"] != "SUCCESS" || results[0]["output"] != "baseline applied" {
+		t.Errorf("unexpected results %v", results)
+	}
+}
+
+func TestCustomizeDiffRejectsServerChanges(t *testing.T) {
+	r := ResourceBPInstance()
+	raw := func(expirationDate string) map[string]interface{} {
+		return map[string]interface{}{
+			"group":           "/api/v3/cmp/groups/GRP-abcd1234/",
+			"blueprint_id":    "BP-abcd1234",
+			"expiration_date": expirationDate,
+			"deployment_item": []interface{}{map[string]interface{}{"name": "server-bdi-abcd1234"}},
+		}
+	}
+
+	for id, rejected := range map[string]bool{
+		"servers:SVR-abcd1234":                         true,
+		"resource:/api/v3/cmp/resources/RSC-abcd1234/": false,
+	} {
+		state := &terraform.InstanceState{ID: id, Attributes: map[string]string{
+			"id":              id,
+			"group":           "/api/v3/cmp/groups/GRP-abcd1234/",
+			"blueprint_id":    "BP-abcd1234",
+			"expiration_date": "2026-12-31",
+		}}
+
+		_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw("2027-01-31")), nil)
+		if (err != nil) != rejected {
+			t.Errorf("%s: expected rejected=%t, got %v", id, rejected, err)
+		}
+	}
+}
+
+func TestCustomizeDiffSkipsEstimateWhenReadOnly(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.ReadOnly = true
+	client.Guardrails.MaxMonthlyRate = 100
+
+	_, err := ResourceBPInstance().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"group":           "/api/v3/cmp/groups/GRP-abcd1234/",
+		"blueprint_id":    "BP-abcd1234",
+		"deployment_item": []interface{}{map[string]interface{}{"name": "server-bdi-abcd1234"}},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,338 @@
+package cmp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceCloudBoltGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudBoltGroupCreate,
+		ReadContext:   resourceCloudBoltGroupRead,
+		UpdateContext: resourceCloudBoltGroupUpdate,
+		DeleteContext: resourceCloudBoltGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudBoltGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the CloudBolt Group",
+			},
+			"parent": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentHref,
+				Description:      "The relative API URL path or global id of the parent CloudBolt Group, unset for top level Groups",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The CloudBolt Group type, e.g. \"Organization\", CloudBolt chooses the default type when unset",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the CloudBolt Group",
+			},
+			"environments": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         hashHrefOrId,
+				Description: "The relative API URL paths or global ids of the CloudBolt Environments available to the Group, left unmanaged when unset",
+			},
+			"blueprints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         hashHrefOrId,
+				Description: "The relative API URL paths or global ids of the CloudBolt Blueprints that list the Group in their groups that can deploy, left unmanaged when unset",
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative API URL path for the CloudBolt Group",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The absolute path to the CloudBolt Group, e.g. \"/My Org/Dept 1\"",
+			},
+		},
+	}
+}
+
+// cloudBoltGroupObject is a Group as read and written by the resource.
+type cloudBoltGroupObject struct {
+	Links struct {
+		Self         cbclient.CloudBoltHALItem   `json:"self"`
+		Environments []cbclient.CloudBoltHALItem `json:"environments"`
+	} `json:"_links"`
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Type        string                    `json:"type"`
+	Description string                    `json:"description"`
+	Parent      cbclient.CloudBoltHALItem `json:"parent"`
+}
+
+func resourceCloudBoltGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	var group cloudBoltGroupObject
+	if err := apiClient.Post(conns.APIEndpoint("cmp", "groups"), groupRequest(d), &group); err != nil {
+		return diag.Errorf("Error creating Group (%s): %s", d.Get("name").(string), err)
+	}
+
+	if group.ID == "" {
+		return diag.Errorf("Error creating Group (%s): CloudBolt did not return the new Group", d.Get("name").(string))
+	}
+
+	d.SetId(group.ID)
+
+	if blueprints, ok := d.GetOk("blueprints"); ok {
+		if err := setGroupDeployableBlueprints(apiClient, conns.APIEndpoint("cmp", "groups", group.ID), schema.NewSet(hashHrefOrId, nil), blueprints.(*schema.Set)); err != nil {
+			return diag.Errorf("Error allowing Group (%s) to deploy Blueprints: %s", group.ID, err)
+		}
+	}
+
+	return resourceCloudBoltGroupRead(ctx, d, m)
+}
+
+func resourceCloudBoltGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	var group cloudBoltGroupObject
+	err := apiClient.Get(conns.APIEndpoint("cmp", "groups", d.Id()), nil, &group)
+	if errors.Is(err, cbclient.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error getting Group (%s): %s", d.Id(), err)
+	}
+
+	groupPath, err := getGroupPath(apiClient, &cbclient.CloudBoltGroup{
+		CloudBoltReferenceFields: cbclient.CloudBoltReferenceFields{Name: group.Name},
+		Parent:                   group.Parent,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environments := make([]interface{}, 0, len(group.Links.Environments))
+	for _, env := range group.Links.Environments {
+		environments = append(environments, env.Href)
+	}
+
+	// The Blueprints a Group can deploy are stored on the Blueprints, not every CloudBolt version links them from the Group.
+	blueprints, err := getGroupDeployableBlueprints(apiClient, group.Links.Self.Href)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", group.Name)
+	d.Set("parent", group.Parent.Href)
+	d.Set("type", group.Type)
+	d.Set("description", group.Description)
+	d.Set("environments", schema.NewSet(hashHrefOrId, environments))
+	d.Set("blueprints", schema.NewSet(hashHrefOrId, blueprints))
+	d.Set("url_path", group.Links.Self.Href)
+	d.Set("path", groupPath)
+
+	return nil
+}
+
+func resourceCloudBoltGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	if reqData := groupUpdateRequest(d); len(reqData) > 0 {
+		if err := apiClient.Patch(conns.APIEndpoint("cmp", "groups", d.Id()), reqData, nil); err != nil {
+			return diag.Errorf("Error updating Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("blueprints") {
+		old, new := d.GetChange("blueprints")
+		if err := setGroupDeployableBlueprints(apiClient, conns.APIEndpoint("cmp", "groups", d.Id()), old.(*schema.Set), new.(*schema.Set)); err != nil {
+			return diag.Errorf("Error changing the Blueprints Group (%s) can deploy: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudBoltGroupRead(ctx, d, m)
+}
+
+func resourceCloudBoltGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := m.(*conns.CloudBoltClient)
+
+	err := apiClient.Delete(conns.APIEndpoint("cmp", "groups", d.Id()))
+	if err != nil && !errors.Is(err, cbclient.ErrNotFound) {
+		return diag.Errorf("Error deleting Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceCloudBoltGroupImport accepts either the global id or the relative API URL path of a Group.
+func resourceCloudBoltGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := path.Base(strings.TrimRight(d.Id(), "/"))
+	if id == "" || id == "." || id == "/" {
+		return nil, fmt.Errorf("Invalid Group import id (%s), expected a global id, e.g. GRP-abcd1234", d.Id())
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// groupRequest builds the Group payload from the resource arguments. Environments are sent when set, or when
+// an existing Group changes them, so the last one can be removed.
+func groupRequest(d *schema.ResourceData) map[string]interface{} {
+	reqData := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+
+	// An existing Group is moved to the top level by clearing its parent.
+	if parent := d.Get("parent").(string); parent != "" {
+		reqData["parent"] = apiHref("groups", parent)
+	} else if d.Id() != "" {
+		reqData["parent"] = nil
+	}
+
+	if groupType := d.Get("type").(string); groupType != "" {
+		reqData["type"] = groupType
+	}
+
+	if v, ok := d.GetOk("environments"); ok || (d.Id() != "" && d.HasChange("environments")) {
+		hrefs := make([]string, 0, v.(*schema.Set).Len())
+		for _, pathOrId := range v.(*schema.Set).List() {
+			hrefs = append(hrefs, apiHref("environments", pathOrId.(string)))
+		}
+		reqData["environments"] = hrefs
+	}
+
+	return reqData
+}
+
+// groupUpdateRequest builds the Group payload with only the changed arguments, so fields CloudBolt sets
+// outside of Terraform, e.g. unmanaged Environments, are left as they are.
+func groupUpdateRequest(d *schema.ResourceData) map[string]interface{} {
+	reqData := make(map[string]interface{})
+	for k, v := range groupRequest(d) {
+		if d.HasChange(k) {
+			reqData[k] = v
+		}
+	}
+
+	return reqData
+}
+
+// getGroupDeployableBlueprints returns the Blueprints that explicitly allow groupHref to deploy them.
+func getGroupDeployableBlueprints(apiClient *conns.CloudBoltClient, groupHref string) ([]interface{}, error) {
+	query := url.Values{}
+	query.Set("filter", conns.Filter("groups_that_can_deploy.id:"+hrefId(groupHref)))
+
+	objects, err := apiClient.GetAll(conns.APIEndpoint("cmp", "blueprints"), query, "blueprints")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Blueprints: %s", err)
+	}
+
+	blueprints := make([]interface{}, 0)
+	for _, object := range objects {
+		var blueprint struct {
+			Links struct {
+				Self                cbclient.CloudBoltHALItem   `json:"self"`
+				GroupsThatCanDeploy []cbclient.CloudBoltHALItem `json:"groupsThatCanDeploy"`
+			} `json:"_links"`
+		}
+		if err := json.Unmarshal(object, &blueprint); err != nil {
+			return nil, err
+		}
+
+		for _, g := range blueprint.Links.GroupsThatCanDeploy {
+			if equivalentHref(g.Href, groupHref) {
+				blueprints = append(blueprints, blueprint.Links.Self.Href)
+				break
+			}
+		}
+	}
+
+	return blueprints, nil
+}
+
+// setGroupDeployableBlueprints adds groupHref to the groups that can deploy each Blueprint added from old to new,
+// and removes it from each Blueprint removed.
+func setGroupDeployableBlueprints(apiClient *conns.CloudBoltClient, groupHref string, old *schema.Set, new *schema.Set) error {
+	for _, v := range new.Difference(old).List() {
+		if err := setBlueprintDeployableBy(apiClient, apiHref("blueprints", v.(string)), groupHref, true); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range old.Difference(new).List() {
+		if err := setBlueprintDeployableBy(apiClient, apiHref("blueprints", v.(string)), groupHref, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setBlueprintDeployableBy adds groupHref to, or removes it from, the groups that can deploy a Blueprint.
+// The other groups are kept as they are.
+func setBlueprintDeployableBy(apiClient *conns.CloudBoltClient, blueprintHref string, groupHref string, canDeploy bool) error {
+	var blueprint struct {
+		Links struct {
+			GroupsThatCanDeploy []cbclient.CloudBoltHALItem `json:"groupsThatCanDeploy"`
+		} `json:"_links"`
+	}
+	if err := apiClient.Get(blueprintHref, nil, &blueprint); err != nil {
+		return fmt.Errorf("Error getting Blueprint (%s): %s", blueprintHref, err)
+	}
+
+	groups := make([]string, 0, len(blueprint.Links.GroupsThatCanDeploy)+1)
+	for _, g := range blueprint.Links.GroupsThatCanDeploy {
+		if !equivalentHref(g.Href, groupHref) {
+			groups = append(groups, g.Href)
+		}
+	}
+	if canDeploy {
+		groups = append(groups, groupHref)
+	}
+
+	if err := apiClient.Patch(blueprintHref, map[string]interface{}{"groupsThatCanDeploy": groups}, nil); err != nil {
+		return fmt.Errorf("Error updating Blueprint (%s): %s", blueprintHref, err)
+	}
+
+	return nil
+}
+
+// apiHref converts a global id to the relative API URL path of a cmp object, API URL paths are returned as is.
+func apiHref(collection string, pathOrId string) string {
+	if strings.HasPrefix(pathOrId, "/") {
+		return pathOrId
+	}
+
+	return conns.APIEndpoint("cmp", collection, pathOrId)
+}
+
+// hashHrefOrId hashes a relative API URL path or global id by its id, so both forms are the same set element.
+func hashHrefOrId(v interface{}) int {
+	return schema.HashString(path.Base(strings.TrimRight(v.(string), "/")))
+}
@@ -0,0 +1,270 @@
+package cmp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testGroupState is the state of a Group managed by the resource, before any drift.
+func testGroupState() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "GRP-abcd1234",
+		Attributes: map[string]string{
+			"id":             "GRP-abcd1234",
+			"name":           "Team A",
+			"description":    "Old description",
+			"parent":         "/api/v3/cmp/groups/GRP-parent01/",
+			"type":           "Organization",
+			"environments.#": "1",
+			"environments." + fmt.Sprint(hashHrefOrId("/api/v3/cmp/environments/ENV-abcd1234/")): "/api/v3/cmp/environments/ENV-abcd1234/",
+		},
+	}
+}
+
+func TestGroupRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceCloudBoltGroup().Schema, map[string]interface{}{
+		"name":         "Team A",
+		"parent":       "GRP-parent01",
+		"environments": []interface{}{"ENV-abcd1234", "/api/v3/cmp/environments/ENV-efgh5678/"},
+	})
+
+	reqData := groupRequest(d)
+	if reqData["parent"] != "/api/v3/cmp/groups/GRP-parent01/" {
+		t.Errorf("expected the parent id to be sent as an API URL path, got %v", reqData["parent"])
+	}
+
+	envs, _ := reqData["environments"].([]string)
+	if len(envs) != 2 {
+		t.Fatalf("expected 2 environments, got %v", reqData["environments"])
+	}
+	for _, env := range envs {
+		if env != "/api/v3/cmp/environments/ENV-abcd1234/" && env != "/api/v3/cmp/environments/ENV-efgh5678/" {
+			t.Errorf("unexpected environment %q", env)
+		}
+	}
+
+	if _, ok := reqData["blueprints"]; ok {
+		t.Errorf("expected unset blueprints to be left out, got %v", reqData["blueprints"])
+	}
+
+	if _, ok := reqData["type"]; ok {
+		t.Errorf("expected unset type to be left out, got %v", reqData["type"])
+	}
+
+	d = schema.TestResourceDataRaw(t, ResourceCloudBoltGroup().Schema, map[string]interface{}{"name": "Team A"})
+	if _, ok := groupRequest(d)["parent"]; ok {
+		t.Errorf("expected no parent for a new top level Group")
+	}
+
+	d.SetId("GRP-abcd1234")
+	if parent, ok := groupRequest(d)["parent"]; !ok || parent != nil {
+		t.Errorf("expected an explicit null parent to move an existing Group to the top level, got %v", parent)
+	}
+}
+
+func TestHashHrefOrId(t *testing.T) {
+	if hashHrefOrId("ENV-abcd1234") != hashHrefOrId("/api/v3/cmp/environments/ENV-abcd1234/") {
+		t.Errorf("expected a global id and its API URL path to hash the same")
+	}
+}
+
+func TestResourceCloudBoltGroupImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceCloudBoltGroup().Schema, map[string]interface{}{})
+	d.SetId("/api/v3/cmp/groups/GRP-abcd1234/")
+
+	result, err := resourceCloudBoltGroupImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 1 || result[0].Id() != "GRP-abcd1234" {
+		t.Errorf("expected the import id to be GRP-abcd1234, got %v", result[0].Id())
+	}
+}
+
+// testGroupUpdateData returns the ResourceData of an update from testGroupState to the raw configuration.
+func testGroupUpdateData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	r := ResourceCloudBoltGroup()
+	state := testGroupState()
+
+	// The configuration is shimmed from cty like Terraform sends it, so explicitly empty sets are kept.
+	attrs := make(map[string]cty.Value)
+	for k, attrType := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attrs[k] = cty.NullVal(attrType)
+	}
+	for k, v := range raw {
+		switch v := v.(type) {
+		case string:
+			attrs[k] = cty.StringVal(v)
+		case []interface{}:
+			elems := make([]cty.Value, 0, len(v))
+			for _, elem := range v {
+				elems = append(elems, cty.StringVal(elem.(string)))
+			}
+			if len(elems) == 0 {
+				attrs[k] = cty.SetValEmpty(cty.String)
+			} else {
+				attrs[k] = cty.SetVal(elems)
+			}
+		}
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(cty.ObjectVal(attrs), r.CoreConfigSchema()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+func TestGroupUpdateRequest(t *testing.T) {
+	d := testGroupUpdateData(t, map[string]interface{}{
+		"name":         "Team A",
+		"description":  "New description",
+		"parent":       "GRP-parent01",
+		"environments": []interface{}{"ENV-abcd1234"},
+	})
+
+	reqData := groupUpdateRequest(d)
+	if len(reqData) != 1 || reqData["description"] != "New description" {
+		t.Errorf("expected only the changed description to be sent, got %v", reqData)
+	}
+}
+
+func TestGroupUpdateRequestRemovesLastEnvironment(t *testing.T) {
+	d := testGroupUpdateData(t, map[string]interface{}{
+		"name":         "Team A",
+		"description":  "Old description",
+		"parent":       "GRP-parent01",
+		"environments": []interface{}{},
+	})
+
+	reqData := groupUpdateRequest(d)
+	envs, ok := reqData["environments"].([]string)
+	if len(reqData) != 1 || !ok || len(envs) != 0 {
+		t.Errorf("expected an empty environments list to be sent, got %v", reqData)
+	}
+
+	d = testGroupUpdateData(t, map[string]interface{}{
+		"name":        "Team A",
+		"description": "Old description",
+		"parent":      "GRP-parent01",
+	})
+	if reqData := groupUpdateRequest(d); len(reqData) != 0 {
+		t.Errorf("expected unset environments to be left unmanaged, got %v", reqData)
+	}
+}
+
+func TestSetGroupDeployableBlueprints(t *testing.T) {
+	requests := make([]testRequest, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, recordRequest(t, r))
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"_links": {"groupsThatCanDeploy": [{"href": "/api/v3/cmp/groups/GRP-other001/"}, {"href": "/api/v3/cmp/groups/GRP-abcd1234/"}]}}`)
+		}
+	})
+
+	old := schema.NewSet(hashHrefOrId, []interface{}{"BP-keep0001", "/api/v3/cmp/blueprints/BP-remove01/"})
+	new := schema.NewSet(hashHrefOrId, []interface{}{"/api/v3/cmp/blueprints/BP-keep0001/"})
+	if err := setGroupDeployableBlueprints(client, "/api/v3/cmp/groups/GRP-abcd1234/", old, new); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected only the removed Blueprint to be updated, got %v", requests)
+	}
+
+	update := requests[1]
+	groups, _ := update.Body["groupsThatCanDeploy"].([]interface{})
+	if update.Method != http.MethodPatch || update.Path != "/api/v3/cmp/blueprints/BP-remove01/" || len(groups) != 1 || groups[0] != "/api/v3/cmp/groups/GRP-other001/" {
+		t.Errorf("expected the Group to be removed from the Blueprint only, got %+v", update)
+	}
+}
+
+func TestResourceCloudBoltGroupUpdate(t *testing.T) {
+	requests := make([]testRequest, 0)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, recordRequest(t, r))
+		fmt.Fprint(w, `{"id": "GRP-abcd1234", "name": "Team A", "_links": {"self": {"href": "/api/v3/cmp/groups/GRP-abcd1234/"}, "environments": [], "blueprints": []}}`)
+	})
+
+	d := testGroupUpdateData(t, map[string]interface{}{
+		"name":        "Team B",
+		"description": "Old description",
+		"parent":      "/api/v3/cmp/groups/GRP-parent01/",
+	})
+
+	if diags := resourceCloudBoltGroupUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	update := requests[0]
+	if update.Method != http.MethodPatch || update.Path != "/api/v3/cmp/groups/GRP-abcd1234/" || len(update.Body) != 1 || update.Body["name"] != "Team B" {
+		t.Errorf("expected a PATCH of the name only, got %+v", update)
+	}
+}
+
+func TestResourceCloudBoltGroupReadDrift(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/cmp/groups/GRP-abcd1234/":
+			fmt.Fprint(w, `{
+				"id": "GRP-abcd1234",
+				"name": "Team A",
+				"description": "Old description",
+				"type": "Organization",
+				"parent": {"href": "/api/v3/cmp/groups/GRP-parent02/"},
+				"_links": {
+					"self": {"href": "/api/v3/cmp/groups/GRP-abcd1234/"},
+					"environments": [{"href": "/api/v3/cmp/environments/ENV-efgh5678/"}]
+				}
+			}`)
+		case "/api/v3/cmp/groups/GRP-parent02/":
+			fmt.Fprint(w, `{"id": "GRP-parent02", "name": "Org 2"}`)
+		case "/api/v3/cmp/blueprints/":
+			if filter := r.URL.Query().Get("filter"); filter != "groups_that_can_deploy.id:GRP-abcd1234" {
+				t.Errorf("unexpected Blueprint filter %q", filter)
+			}
+			fmt.Fprint(w, `{"total": 1, "_embedded": {"blueprints": [
+				{"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-abcd1234/"}, "groupsThatCanDeploy": [{"href": "/api/v3/cmp/groups/GRP-abcd1234/"}]}}
+			]}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := ResourceCloudBoltGroup().Data(testGroupState())
+	if diags := resourceCloudBoltGroupRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if parent := d.Get("parent").(string); parent != "/api/v3/cmp/groups/GRP-parent02/" {
+		t.Errorf("expected the moved parent in state, got %q", parent)
+	}
+
+	if path := d.Get("path").(string); path != "/Org 2/Team A" {
+		t.Errorf("unexpected path %q", path)
+	}
+
+	envs := d.Get("environments").(*schema.Set)
+	if envs.Len() != 1 || !envs.Contains("ENV-efgh5678") {
+		t.Errorf("expected the changed environments in state, got %v", envs.List())
+	}
+
+	blueprints := d.Get("blueprints").(*schema.Set)
+	if blueprints.Len() != 1 || !blueprints.Contains("BP-abcd1234") {
+		t.Errorf("expected the deployable blueprints in state, got %v", blueprints.List())
+	}
+}
@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func JobStatusStateRefreshFunc(apiClient *cbclient.CloudBoltClient, jobStatusPath string) resource.StateRefreshFunc {
+func JobStatusStateRefreshFunc(apiClient *conns.CloudBoltClient, jobStatusPath string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		jobStatus, err := apiClient.GetJobStatus(jobStatusPath)
 		if err != nil {
@@ -23,7 +24,7 @@ func JobStatusStateRefreshFunc(apiClient *cbclient.CloudBoltClient, jobStatusPat
 	}
 }
 
-func GetJobStautusStateChangeConf(apiClient *cbclient.CloudBoltClient, requestTimeout int, jobStatusPath string) resource.StateChangeConf {
+func GetJobStautusStateChangeConf(apiClient *conns.CloudBoltClient, requestTimeout int, jobStatusPath string) resource.StateChangeConf {
 	return resource.StateChangeConf{
 		Delay:   10 * time.Second,
 		Timeout: time.Duration(requestTimeout) * time.Minute,
@@ -35,3 +36,19 @@ func GetJobStautusStateChangeConf(apiClient *cbclient.CloudBoltClient, requestTi
 		Refresh: JobStatusStateRefreshFunc(apiClient, jobStatusPath),
 	}
 }
+
+// getTemplateProperties merges "sensitive_template_properties" over "template_properties"
+// so the sensitive values are only sent to OneFuse and never stored in a plain attribute.
+func getTemplateProperties(d *schema.ResourceData) map[string]interface{} {
+	templateProperties := make(map[string]interface{})
+
+	for k, v := range d.Get("template_properties").(map[string]interface{}) {
+		templateProperties[k] = v
+	}
+
+	for k, v := range d.Get("sensitive_template_properties").(map[string]interface{}) {
+		templateProperties[k] = v
+	}
+
+	return templateProperties
+}
@@ -10,7 +10,7 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,7 +40,7 @@ func DataSourceAnsibleTowerPolicy() *schema.Resource {
 }
 
 func dataSourceAnsibleTowerPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	name := d.Get("name").(string)
 
 	ansibleTowerPolicy, err := apiClient.GetAnsibleTowerPolicy(name)
@@ -11,7 +11,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 
-	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,7 +40,7 @@ func DataSourceRenderedTemplate() *schema.Resource {
 }
 
 func dataSourceRenderedTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	renderedTemplate, err := apiClient.RenderTemplate(d.Get("template").(string), d.Get("template_properties").(map[string]interface{}))
 
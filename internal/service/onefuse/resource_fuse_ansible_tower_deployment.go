@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -54,6 +55,12 @@ func ResourceAnsibleTowerDeployment() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"inventory_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -87,10 +94,10 @@ func resourceAnsibleTowerDeploymentCreate(ctx context.Context, d *schema.Resourc
 		WorkspaceURL:       d.Get("workspace_url").(string),
 		Hosts:              hosts,
 		Limit:              d.Get("limit").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	jobStatus, err := apiClient.CreateAnsibleTowerDeployment(&newAnsibleTowerDeployment)
 	if err != nil {
 		return diag.FromErr(err)
@@ -122,7 +129,7 @@ func resourceAnsibleTowerDeploymentCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAnsibleTowerDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	ansibleDeployment, err := apiClient.GetAnsibleTowerDeploymentById(d.Id())
@@ -189,7 +196,7 @@ func resourceAnsibleTowerDeploymentUpdate(ctx context.Context, d *schema.Resourc
 
 func resourceAnsibleTowerDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("onefuse.resourceAnsibleTowerDeploymentDelete")
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteAnsibleTowerDeployment(d.Id())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,6 +53,12 @@ func ResourceDNSReservation() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -63,7 +70,7 @@ func ResourceDNSReservation() *schema.Resource {
 }
 
 func resourceDNSReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	var dnsZones []string
 	for _, group := range d.Get("zones").([]interface{}) {
@@ -76,7 +83,7 @@ func resourceDNSReservationCreate(ctx context.Context, d *schema.ResourceData, m
 		WorkspaceURL:       d.Get("workspace_url").(string),
 		Value:              d.Get("value").(string),
 		Zones:              dnsZones,
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateDNSReservation(&newDNSRecord)
@@ -110,7 +117,7 @@ func resourceDNSReservationCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceDNSReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	dnsRecord, err := apiClient.GetDNSReservationById(d.Id())
@@ -149,7 +156,7 @@ func resourceDNSReservationUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceDNSReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteDNSReservation(d.Id())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -106,6 +107,12 @@ func ResourceIPAMReservation() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -117,7 +124,7 @@ func ResourceIPAMReservation() *schema.Resource {
 }
 
 func resourceIPAMReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	var ipam_Suffixes []string
 	for _, group := range d.Get("dns_search_suffix").([]interface{}) {
@@ -137,7 +144,7 @@ func resourceIPAMReservationCreate(ctx context.Context, d *schema.ResourceData,
 		SecondaryDNS:       d.Get("secondary_dns").(string),
 		DNSSuffix:          d.Get("dns_suffix").(string),
 		NicLabel:           d.Get("nic_label").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 	jobStatus, err := apiClient.CreateIPAMReservation(&newIPAMRecord)
 	if err != nil {
@@ -170,7 +177,7 @@ func resourceIPAMReservationCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIPAMReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	ipamRecord, err := apiClient.GetIPAMReservationById(d.Id())
@@ -263,7 +270,7 @@ func resourceIPAMReservationUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIPAMReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteIPAMReservation(d.Id())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -56,6 +57,12 @@ func ResourceMicrosoftADComputerAccount() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -67,14 +74,14 @@ func ResourceMicrosoftADComputerAccount() *schema.Resource {
 }
 
 func resourceMicrosoftADComputerAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	newComputerAccount := cbclient.MicrosoftADComputerAccount{
 		Name:               d.Get("name").(string),
 		FinalOU:            d.Get("final_ou").(string),
 		PolicyID:           d.Get("policy_id").(int),
 		WorkspaceURL:       d.Get("workspace_url").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateMicrosoftADComputerAccount(&newComputerAccount)
@@ -108,7 +115,7 @@ func resourceMicrosoftADComputerAccountCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceMicrosoftADComputerAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	computerAccount, err := apiClient.GetMicrosoftADComputerAccountById(d.Id())
@@ -153,7 +160,7 @@ func resourceMicrosoftADComputerAccountUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceMicrosoftADComputerAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteMicrosoftADComputerAccount(d.Id())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -72,7 +73,7 @@ func ResourceMicrosoftADPolicy() *schema.Resource {
 }
 
 func resourceMicrosoftADPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	var securityGroups []string
 	for _, group := range d.Get("security_groups").([]interface{}) {
@@ -102,7 +103,7 @@ func resourceMicrosoftADPolicyCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMicrosoftADPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	policy, err := apiClient.GetMicrosoftADPolicyByID(d.Id())
@@ -193,7 +194,7 @@ func resourceMicrosoftADPolicyUpdate(ctx context.Context, d *schema.ResourceData
 		return nil
 	}
 
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	var securityGroups []string
 	for _, group := range d.Get("security_groups").([]interface{}) {
@@ -221,7 +222,7 @@ func resourceMicrosoftADPolicyUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMicrosoftADPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	err := apiClient.DeleteMicrosoftADPolicy(d.Id())
 	if err != nil {
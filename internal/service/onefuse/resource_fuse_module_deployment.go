@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,6 +42,12 @@ func ResourceModuleDeployment() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"provisioning_job_results": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,12 +69,12 @@ func ResourceModuleDeployment() *schema.Resource {
 }
 
 func resourceModuleDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	newModuleDeployment := cbclient.ModuleDeployment{
 		PolicyID:           d.Get("policy_id").(int),
 		WorkspaceURL:       d.Get("workspace_url").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateModuleDeployment(&newModuleDeployment)
@@ -101,7 +108,7 @@ func resourceModuleDeploymentCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceModuleDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	moduleDeployment, err := apiClient.GetModuleDeploymentById(d.Id())
@@ -168,7 +175,7 @@ func resourceModuleDeploymentUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceModuleDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteModuleDeployment(d.Id())
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -49,6 +50,12 @@ func ResourceCustomNaming() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -60,11 +67,11 @@ func ResourceCustomNaming() *schema.Resource {
 }
 
 func resourceCustomNameCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	namingPolicyID := d.Get("naming_policy_id").(string)
 	workspaceID := d.Get("workspace_id").(string)
-	templateProperties := d.Get("template_properties").(map[string]interface{})
+	templateProperties := getTemplateProperties(d)
 
 	jobStatus, err := apiClient.GenerateCustomName(namingPolicyID, workspaceID, templateProperties)
 	if err != nil {
@@ -107,7 +114,7 @@ func resourceCustomNameCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceCustomNameRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	customNameId := strconv.Itoa(d.Get("custom_name_id").(int))
@@ -137,7 +144,7 @@ func resourceCustomNameUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceCustomNameDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	customNameId := strconv.Itoa(d.Get("custom_name_id").(int))
 	jobStatus, err := apiClient.DeleteCustomName(customNameId)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,6 +41,12 @@ func ResourceScriptingDeployment() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"provisioning_details": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -56,12 +63,12 @@ func ResourceScriptingDeployment() *schema.Resource {
 }
 
 func resourceScriptingDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	newScriptingDeployment := cbclient.ScriptingDeployment{
 		PolicyID:           d.Get("policy_id").(int),
 		WorkspaceURL:       d.Get("workspace_url").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateScriptingDeployment(&newScriptingDeployment)
@@ -95,7 +102,7 @@ func resourceScriptingDeploymentCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScriptingDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	scriptingDeployment, err := apiClient.GetScriptingDeploymentById(d.Id())
@@ -148,7 +155,7 @@ func resourceScriptingDeploymentUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScriptingDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteScriptingDeployment(d.Id())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -48,6 +49,12 @@ func ResourceServicenowCMDBDeployment() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -59,12 +66,12 @@ func ResourceServicenowCMDBDeployment() *schema.Resource {
 }
 
 func resourceServicenowCMDBDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	newServicenowCMDBDeployment := cbclient.ServicenowCMDBDeployment{
 		PolicyID:           d.Get("policy_id").(int),
 		WorkspaceURL:       d.Get("workspace_url").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateServicenowCMDBDeployment(&newServicenowCMDBDeployment)
@@ -98,7 +105,7 @@ func resourceServicenowCMDBDeploymentCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceServicenowCMDBDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	snowDeployment, err := apiClient.GetServicenowCMDBDeploymentById(d.Id())
@@ -151,7 +158,7 @@ func resourceServicenowCMDBDeploymentUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceServicenowCMDBDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteServicenowCMDBDeployment(d.Id())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient"
+	"github.com/cloudboltsoftware/terraform-provider-cloudbolt/internal/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,6 +42,12 @@ func ResourceVraDeployment() *schema.Resource {
 				Optional:    true,
 				Description: "Additional properties that are referenced within the Policy.",
 			},
+			"sensitive_template_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Additional properties that are referenced within the Policy and must not be shown in plans or logs. Merged over \"template_properties\".",
+			},
 			"deployment_info": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -67,13 +74,13 @@ func ResourceVraDeployment() *schema.Resource {
 }
 
 func resourceVraDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	newVraDeployment := cbclient.VraDeployment{
 		PolicyID:           d.Get("policy_id").(int),
 		WorkspaceURL:       d.Get("workspace_url").(string),
 		DeploymentName:     d.Get("deployment_name").(string),
-		TemplateProperties: d.Get("template_properties").(map[string]interface{}),
+		TemplateProperties: getTemplateProperties(d),
 	}
 
 	jobStatus, err := apiClient.CreateVraDeployment(&newVraDeployment)
@@ -107,7 +114,7 @@ func resourceVraDeploymentCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceVraDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 	var diags diag.Diagnostics
 
 	vraDeployment, err := apiClient.GetVraDeploymentById(d.Id())
@@ -171,7 +178,7 @@ func resourceVraDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceVraDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*cbclient.CloudBoltClient)
+	apiClient := m.(*conns.CloudBoltClient)
 
 	jobStatus, err := apiClient.DeleteVraDeployment(d.Id())
 	if err != nil {